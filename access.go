@@ -0,0 +1,79 @@
+package slicy
+
+// At gets the element at index `n` of `slice`, with negative values of `n` counting back from the
+// end. Unlike `Nth`, it returns the zero value and false instead of panicking when `n` is out of range.
+func At[S ~[]T, T any](slice S, n int) (result T, ok bool) {
+	if n < 0 {
+		n += len(slice)
+	}
+	if n < 0 || n >= len(slice) {
+		return
+	}
+	return slice[n], true
+}
+
+// NthOk is the same as `At`: it gets the element at index `n` of `slice`, with negative values of `n`
+// counting back from the end, and returns false when `n` is out of range.
+func NthOk[S ~[]T, T any](slice S, n int) (T, bool) {
+	return At(slice, n)
+}
+
+// First returns the first element of `slice`. For an empty slice it returns the zero value and false.
+func First[S ~[]T, T any](slice S) (T, bool) {
+	return At(slice, 0)
+}
+
+// FirstOr returns the first element of `slice`, or `fallback` if the slice is empty.
+func FirstOr[S ~[]T, T any](slice S, fallback T) T {
+	if v, ok := First(slice); ok {
+		return v
+	}
+	return fallback
+}
+
+// Last returns the last element of `slice`. For an empty slice it returns the zero value and false.
+func Last[S ~[]T, T any](slice S) (T, bool) {
+	return At(slice, -1)
+}
+
+// LastOr returns the last element of `slice`, or `fallback` if the slice is empty.
+func LastOr[S ~[]T, T any](slice S, fallback T) T {
+	if v, ok := Last(slice); ok {
+		return v
+	}
+	return fallback
+}
+
+// FindOk iterates over the elements of `slice`, returning the first element that `predicate` returns
+// true for along with its index. Unlike `Find`, it returns false if no element matches, so that a
+// matching zero value can be told apart from no match.
+func FindOk[S ~[]T, T any](slice S, predicate func(value T, index int, slice S) bool) (result T, index int, ok bool) {
+	for i, item := range slice {
+		if predicate(item, i, slice) {
+			return item, i, true
+		}
+	}
+	return result, -1, false
+}
+
+// FindLast iterates over the elements of `slice` from right to left, returning the last element that
+// `predicate` returns true for along with its index. It returns false if no element matches.
+func FindLast[S ~[]T, T any](slice S, predicate func(value T, index int, slice S) bool) (result T, index int, ok bool) {
+	for i := len(slice) - 1; i >= 0; i-- {
+		if predicate(slice[i], i, slice) {
+			return slice[i], i, true
+		}
+	}
+	return result, -1, false
+}
+
+// Slice returns the part of `slice` from `start` up to, but not including `end`, sharing memory with
+// `slice`. Like JavaScript's `Array.prototype.slice`, negative indexes count back from the end of the
+// slice, out of range indexes are clamped, and an `end` before `start` results in an empty slice.
+func Slice[S ~[]T, T any](slice S, start int, end int) S {
+	start, end = relativeIndex(start, len(slice)), relativeIndex(end, len(slice))
+	if end < start {
+		end = start
+	}
+	return slice[start:end]
+}
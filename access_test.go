@@ -0,0 +1,129 @@
+package slicy
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestSlice(t *testing.T) {
+	input := []int{1, 2, 3, 4, 5}
+	tests := []struct {
+		name       string
+		start, end int
+		o          []int
+	}{
+		{"all", 0, 5, []int{1, 2, 3, 4, 5}},
+		{"middle", 1, 3, []int{2, 3}},
+		{"negative start", -2, 5, []int{4, 5}},
+		{"negative end", 0, -1, []int{1, 2, 3, 4}},
+		{"both negative", -3, -1, []int{3, 4}},
+		{"clamped", -10, 10, []int{1, 2, 3, 4, 5}},
+		{"reversed", 3, 1, []int{}},
+		{"past end", 7, 9, []int{}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if op := Slice(input, test.start, test.end); !reflect.DeepEqual(op, test.o) {
+				t.Error(test.o, op)
+			}
+		})
+	}
+}
+
+func TestNegativeCounts(t *testing.T) {
+	input := []int{1, 2, 3, 4, 5}
+	tests := []struct {
+		name string
+		op   []int
+		o    []int
+	}{
+		{"take", Take(input, -2), []int{1, 2, 3}},
+		{"take all", Take(input, -10), []int{}},
+		{"drop", Drop(input, -2), []int{4, 5}},
+		{"drop all", Drop(input, -10), []int{1, 2, 3, 4, 5}},
+		{"take right", TakeRight(input, -2), []int{3, 4, 5}},
+		{"take right zero", TakeRight(input, 0), []int{}},
+		{"drop right", DropRight(input, -2), []int{1, 2}},
+		{"drop right zero", DropRight(input, 0), []int{1, 2, 3, 4, 5}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if !reflect.DeepEqual(test.op, test.o) {
+				t.Error(test.o, test.op)
+			}
+		})
+	}
+}
+
+func ExampleAt() {
+	fmt.Println(At([]string{"a", "b", "c"}, 1))
+	fmt.Println(At([]string{"a", "b", "c"}, -1))
+	fmt.Println(At([]string{"a", "b", "c"}, 3))
+	// Output:
+	// b true
+	// c true
+	//  false
+}
+
+func ExampleNthOk() {
+	fmt.Println(NthOk([]int{0, 1}, 0))
+	fmt.Println(NthOk([]int{0, 1}, -3))
+	// Output:
+	// 0 true
+	// 0 false
+}
+
+func ExampleFirst() {
+	fmt.Println(First([]int{1, 2}))
+	fmt.Println(First([]int{}))
+	// Output:
+	// 1 true
+	// 0 false
+}
+
+func ExampleFirstOr() {
+	fmt.Println(FirstOr([]string{"a"}, "none"))
+	fmt.Println(FirstOr([]string{}, "none"))
+	// Output:
+	// a
+	// none
+}
+
+func ExampleLast() {
+	fmt.Println(Last([]int{1, 2}))
+	fmt.Println(Last([]int{}))
+	// Output:
+	// 2 true
+	// 0 false
+}
+
+func ExampleLastOr() {
+	fmt.Println(LastOr([]int{1, 2}, -1))
+	fmt.Println(LastOr([]int{}, -1))
+	// Output:
+	// 2
+	// -1
+}
+
+func ExampleFindOk() {
+	fmt.Println(FindOk([]int{3, 0, 1}, func(v int, _ int, _ []int) bool { return v == 0 }))
+	fmt.Println(FindOk([]int{3, 1}, func(v int, _ int, _ []int) bool { return v == 0 }))
+	// Output:
+	// 0 1 true
+	// 0 -1 false
+}
+
+func ExampleFindLast() {
+	fmt.Println(FindLast([]int{1, 2, 3, 4}, func(v int, _ int, _ []int) bool { return v%2 == 1 }))
+	// Output:
+	// 3 2 true
+}
+
+func ExampleSlice() {
+	fmt.Println(Slice([]int{1, 2, 3, 4, 5}, 1, -1))
+	fmt.Println(Slice([]int{1, 2, 3, 4, 5}, -2, 100))
+	// Output:
+	// [2 3 4]
+	// [4 5]
+}
@@ -0,0 +1,137 @@
+package slicy
+
+import (
+	"golang.org/x/exp/constraints"
+)
+
+// KeyValue is a key along with the value aggregated for it.
+type KeyValue[K comparable, V any] struct {
+	Key   K
+	Value V
+}
+
+// AggregateBy groups the elements of `slice` by the key `iteratee` returns for them, and reduces each
+// group in a single pass with `reducer`, starting from `accumulator`. Each group starts from its own copy
+// of `accumulator`, so it should not be a pointer, slice or map unless `reducer` replaces it instead of
+// modifying it. Keys are returned in the order they are first seen in `slice`.
+func AggregateBy[S ~[]T, T any, K comparable, A any](slice S, iteratee func(T) K, accumulator A, reducer func(acc A, value T) A) []KeyValue[K, A] {
+	output := make([]KeyValue[K, A], 0)
+	positions := make(map[K]int)
+	for _, item := range slice {
+		key := iteratee(item)
+		p, found := positions[key]
+		if !found {
+			p = len(output)
+			positions[key] = p
+			output = append(output, KeyValue[K, A]{key, accumulator})
+		}
+		output[p].Value = reducer(output[p].Value, item)
+	}
+	return output
+}
+
+// SumByGroup groups the elements of `slice` by the key `iteratee` returns for them, and totals the result
+// of passing each element through `value` for every group. Keys are returned in first-seen order.
+func SumByGroup[S ~[]T, T any, K comparable, U Number](slice S, iteratee func(T) K, value func(T) U) []KeyValue[K, U] {
+	return AggregateBy(slice, iteratee, 0, func(acc U, item T) U { return acc + value(item) })
+}
+
+// MeanByGroup groups the elements of `slice` by the key `iteratee` returns for them, and averages the result
+// of passing each element through `value` for every group. Keys are returned in first-seen order.
+func MeanByGroup[S ~[]T, T any, K comparable, U Number](slice S, iteratee func(T) K, value func(T) U) []KeyValue[K, float64] {
+	type running struct {
+		total float64
+		count int
+	}
+	sums := AggregateBy(slice, iteratee, running{}, func(acc running, item T) running {
+		return running{acc.total + float64(value(item)), acc.count + 1}
+	})
+	return Map(sums, func(kv KeyValue[K, running]) KeyValue[K, float64] {
+		return KeyValue[K, float64]{kv.Key, kv.Value.total / float64(kv.Value.count)}
+	})
+}
+
+// MinByGroup groups the elements of `slice` by the key `iteratee` returns for them, and picks the element
+// of each group with the smallest result from `value`, keeping the first in case of ties. Keys are returned
+// in first-seen order.
+func MinByGroup[S ~[]T, T any, K comparable, U constraints.Ordered](slice S, iteratee func(T) K, value func(T) U) []KeyValue[K, T] {
+	return extremeByGroup(slice, iteratee, value, func(a, b U) bool { return a < b })
+}
+
+// MaxByGroup groups the elements of `slice` by the key `iteratee` returns for them, and picks the element
+// of each group with the largest result from `value`, keeping the first in case of ties. Keys are returned
+// in first-seen order.
+func MaxByGroup[S ~[]T, T any, K comparable, U constraints.Ordered](slice S, iteratee func(T) K, value func(T) U) []KeyValue[K, T] {
+	return extremeByGroup(slice, iteratee, value, func(a, b U) bool { return a > b })
+}
+
+func extremeByGroup[S ~[]T, T any, K comparable, U constraints.Ordered](slice S, iteratee func(T) K, value func(T) U, better func(a, b U) bool) []KeyValue[K, T] {
+	type best struct {
+		item  T
+		value U
+		set   bool
+	}
+	bests := AggregateBy(slice, iteratee, best{}, func(acc best, item T) best {
+		if v := value(item); !acc.set || better(v, acc.value) {
+			return best{item, v, true}
+		}
+		return acc
+	})
+	return Map(bests, func(kv KeyValue[K, best]) KeyValue[K, T] { return KeyValue[K, T]{kv.Key, kv.Value.item} })
+}
+
+// PivotTable is a two dimensional summary built by `Pivot`. `Cells[i][j]` holds the aggregate for
+// `Rows[i]` and `Columns[j]`, or the zero value if no element had that combination of keys.
+type PivotTable[R, C comparable, V any] struct {
+	Rows    []R
+	Columns []C
+	Cells   [][]V
+
+	rowIndex    map[R]int
+	columnIndex map[C]int
+	filled      [][]bool
+}
+
+// Cell returns the aggregate for the given `row` and `column`, and whether any element had that
+// combination of keys.
+func (p *PivotTable[R, C, V]) Cell(row R, column C) (result V, ok bool) {
+	i, foundRow := p.rowIndex[row]
+	j, foundColumn := p.columnIndex[column]
+	if !foundRow || !foundColumn || !p.filled[i][j] {
+		return
+	}
+	return p.Cells[i][j], true
+}
+
+// Pivot builds a table with a row for each key `rowKey` returns and a column for each key `columnKey`
+// returns, both in first-seen order. Each cell holds the result of passing the values `value` returns for
+// the elements with that row and column to `aggregate`, which is never called with an empty slice.
+func Pivot[S ~[]T, T any, R, C comparable, U any, V any](slice S, rowKey func(T) R, columnKey func(T) C, value func(T) U, aggregate func(values []U) V) *PivotTable[R, C, V] {
+	table := &PivotTable[R, C, V]{rowIndex: make(map[R]int), columnIndex: make(map[C]int)}
+	type cell struct{ row, column int }
+	values := make(map[cell][]U)
+	for _, item := range slice {
+		r, c := rowKey(item), columnKey(item)
+		if _, found := table.rowIndex[r]; !found {
+			table.rowIndex[r] = len(table.Rows)
+			table.Rows = append(table.Rows, r)
+		}
+		if _, found := table.columnIndex[c]; !found {
+			table.columnIndex[c] = len(table.Columns)
+			table.Columns = append(table.Columns, c)
+		}
+		key := cell{table.rowIndex[r], table.columnIndex[c]}
+		values[key] = append(values[key], value(item))
+	}
+	table.Cells = make([][]V, len(table.Rows))
+	table.filled = make([][]bool, len(table.Rows))
+	for i := range table.Rows {
+		table.Cells[i] = make([]V, len(table.Columns))
+		table.filled[i] = make([]bool, len(table.Columns))
+	}
+	for key, cellValues := range values {
+		table.Cells[key.row][key.column] = aggregate(cellValues)
+		table.filled[key.row][key.column] = true
+	}
+	return table
+}
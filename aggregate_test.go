@@ -0,0 +1,67 @@
+package slicy
+
+import (
+	"fmt"
+)
+
+type sale struct {
+	customer string
+	month    string
+	amount   int
+}
+
+var sales = []sale{
+	{"bob", "jan", 10},
+	{"ann", "jan", 5},
+	{"bob", "feb", 7},
+	{"bob", "jan", 3},
+	{"cat", "feb", 2},
+}
+
+func saleCustomer(s sale) string { return s.customer }
+func saleAmount(s sale) int      { return s.amount }
+
+func ExampleAggregateBy() {
+	fmt.Println(AggregateBy(sales, saleCustomer, 0, func(total int, s sale) int { return total + s.amount }))
+	fmt.Println(AggregateBy(sales, saleCustomer, "", func(months string, s sale) string { return months + s.month }))
+	// Output:
+	// [{bob 20} {ann 5} {cat 2}]
+	// [{bob janfebjan} {ann jan} {cat feb}]
+}
+
+func ExampleSumByGroup() {
+	fmt.Println(SumByGroup(sales, saleCustomer, saleAmount))
+	// Output:
+	// [{bob 20} {ann 5} {cat 2}]
+}
+
+func ExampleMeanByGroup() {
+	fmt.Println(MeanByGroup(sales, func(s sale) string { return s.month }, saleAmount))
+	// Output:
+	// [{jan 6} {feb 4.5}]
+}
+
+func ExampleMinByGroup() {
+	fmt.Println(MinByGroup(sales, saleCustomer, saleAmount))
+	// Output:
+	// [{bob {bob jan 3}} {ann {ann jan 5}} {cat {cat feb 2}}]
+}
+
+func ExampleMaxByGroup() {
+	fmt.Println(MaxByGroup(sales, saleCustomer, saleAmount))
+	// Output:
+	// [{bob {bob jan 10}} {ann {ann jan 5}} {cat {cat feb 2}}]
+}
+
+func ExamplePivot() {
+	table := Pivot(sales, saleCustomer, func(s sale) string { return s.month }, saleAmount, Sum[[]int])
+	fmt.Println(table.Rows, table.Columns)
+	fmt.Println(table.Cells)
+	fmt.Println(table.Cell("ann", "feb"))
+	fmt.Println(table.Cell("bob", "jan"))
+	// Output:
+	// [bob ann cat] [jan feb]
+	// [[13 7] [5 0] [0 2]]
+	// 0 false
+	// 13 true
+}
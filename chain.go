@@ -0,0 +1,142 @@
+package slicy
+
+// Chain wraps a slice so that operations can be written left to right, as in
+// `From(xs).Filter(p).Uniq().Take(10).Value()`, instead of nesting calls inside out. Each method calls
+// the function of the same name and returns a new chain, leaving the original unchanged. As with those
+// functions, steps like `Take` and `Drop` share memory with the slice they are given.
+//
+// Chains hold elements of any type. Steps that need comparable elements, like `UniqChain`, `WithoutChain`
+// and the set operations, or that change the element type, like `MapChain` and `FlatMapChain`, are free
+// functions that take and return a chain, since methods cannot add constraints or type parameters.
+type Chain[T any] struct {
+	items []T
+}
+
+// From starts a chain of operations on `slice`.
+func From[S ~[]T, T any](slice S) Chain[T] {
+	return Chain[T]{slice}
+}
+
+// Value returns the slice at the end of the chain.
+func (c Chain[T]) Value() []T {
+	return c.items
+}
+
+// Len returns the number of elements in the chain.
+func (c Chain[T]) Len() int {
+	return len(c.items)
+}
+
+// Filter keeps the elements that `predicate` returns true for. See `Filter`.
+func (c Chain[T]) Filter(predicate func(value T, index int, slice []T) bool) Chain[T] {
+	return Chain[T]{Filter(c.items, predicate)}
+}
+
+// Reject removes the elements that `predicate` returns true for. See `Reject`.
+func (c Chain[T]) Reject(predicate func(value T, index int, slice []T) bool) Chain[T] {
+	return Chain[T]{Reject(c.items, predicate)}
+}
+
+// UniqWith keeps the first occurrence of each element, comparing them with `comparator`. See `UniqWith`.
+func (c Chain[T]) UniqWith(comparator func(T, T) bool) Chain[T] {
+	return Chain[T]{UniqWith(comparator, c.items)}
+}
+
+// Take keeps `n` elements from the beginning. See `Take`.
+func (c Chain[T]) Take(n int) Chain[T] {
+	return Chain[T]{Take(c.items, n)}
+}
+
+// TakeRight keeps `n` elements from the end. See `TakeRight`.
+func (c Chain[T]) TakeRight(n int) Chain[T] {
+	return Chain[T]{TakeRight(c.items, n)}
+}
+
+// TakeWhile keeps elements from the beginning until `predicate` returns false. See `TakeWhile`.
+func (c Chain[T]) TakeWhile(predicate func(value T, index int, slice []T) bool) Chain[T] {
+	return Chain[T]{TakeWhile(c.items, predicate)}
+}
+
+// TakeRightWhile keeps elements from the end until `predicate` returns false. See `TakeRightWhile`.
+func (c Chain[T]) TakeRightWhile(predicate func(value T, index int, slice []T) bool) Chain[T] {
+	return Chain[T]{TakeRightWhile(c.items, predicate)}
+}
+
+// Drop removes `n` elements from the beginning. See `Drop`.
+func (c Chain[T]) Drop(n int) Chain[T] {
+	return Chain[T]{Drop(c.items, n)}
+}
+
+// DropRight removes `n` elements from the end. See `DropRight`.
+func (c Chain[T]) DropRight(n int) Chain[T] {
+	return Chain[T]{DropRight(c.items, n)}
+}
+
+// DropWhile removes elements from the beginning until `predicate` returns false. See `DropWhile`.
+func (c Chain[T]) DropWhile(predicate func(value T, index int, slice []T) bool) Chain[T] {
+	return Chain[T]{DropWhile(c.items, predicate)}
+}
+
+// DropRightWhile removes elements from the end until `predicate` returns false. See `DropRightWhile`.
+func (c Chain[T]) DropRightWhile(predicate func(value T, index int, slice []T) bool) Chain[T] {
+	return Chain[T]{DropRightWhile(c.items, predicate)}
+}
+
+// Reverse reverses the order of the elements. See `Reverse`.
+func (c Chain[T]) Reverse() Chain[T] {
+	return Chain[T]{Reverse(c.items)}
+}
+
+// Chunk ends the chain by splitting its elements into slices of length `chunkSize`. See `Chunk`.
+func (c Chain[T]) Chunk(chunkSize int) [][]T {
+	return Chunk(c.items, chunkSize)
+}
+
+// MapChain continues `chain` with the result of running each of its elements through `iteratee`. See `Map`.
+func MapChain[T, U any](chain Chain[T], iteratee func(T) U) Chain[U] {
+	return Chain[U]{Map(chain.items, iteratee)}
+}
+
+// FlatMapChain continues `chain` with the flattened results of running each of its elements through
+// `iteratee`. See `FlatMap`.
+func FlatMapChain[T, U any](chain Chain[T], iteratee func(value T, index int, slice []T) []U) Chain[U] {
+	return Chain[U]{FlatMap(chain.items, iteratee)}
+}
+
+// UniqChain continues `chain` with the first occurrence of each of its elements. See `Uniq`.
+func UniqChain[T comparable](chain Chain[T]) Chain[T] {
+	return Chain[T]{Uniq(chain.items)}
+}
+
+// UniqByChain continues `chain` with the first occurrence of each of its elements, comparing the results
+// of passing them through `iteratee`. See `UniqBy`.
+func UniqByChain[T any, U comparable](chain Chain[T], iteratee func(T) U) Chain[T] {
+	return Chain[T]{UniqBy(iteratee, chain.items)}
+}
+
+// WithoutChain continues `chain` with all occurrences of `values` removed. See `Without`.
+func WithoutChain[T comparable](chain Chain[T], values ...T) Chain[T] {
+	return Chain[T]{Without(chain.items, values...)}
+}
+
+// UnionChain continues `chain` with the elements of `others` added, keeping one of each. See `Union`.
+func UnionChain[T comparable](chain Chain[T], others ...[]T) Chain[T] {
+	return Chain[T]{Union(append([][]T{chain.items}, others...)...)}
+}
+
+// IntersectionChain continues `chain` with its unique elements that are also in all of `others`.
+// See `Intersection`.
+func IntersectionChain[T comparable](chain Chain[T], others ...[]T) Chain[T] {
+	return Chain[T]{Intersection(append([][]T{chain.items}, others...)...)}
+}
+
+// DifferenceChain continues `chain` with the elements that are in any of `others` removed. See `Difference`.
+func DifferenceChain[T comparable](chain Chain[T], others ...[]T) Chain[T] {
+	return Chain[T]{Difference(chain.items, others...)}
+}
+
+// XorChain continues `chain` with the unique elements that are in it or any of `others`, but not in all
+// of them. See `Xor`.
+func XorChain[T comparable](chain Chain[T], others ...[]T) Chain[T] {
+	return Chain[T]{Xor(append([][]T{chain.items}, others...)...)}
+}
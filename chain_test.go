@@ -0,0 +1,85 @@
+package slicy
+
+import (
+	"fmt"
+	"strings"
+)
+
+func ExampleFrom() {
+	odd := func(v int, _ int, _ []int) bool { return v%2 == 1 }
+	fmt.Println(From([]int{1, 3, 2, 3, 5, 7, 9, 1}).Filter(odd).Take(5).Value())
+	fmt.Println(From([]int{1, 2, 3, 4}).Reverse().DropRight(1).Value())
+	fmt.Println(From([][]int{{1}, {2, 3}, {}}).Reject(func(v []int, _ int, _ [][]int) bool { return len(v) == 0 }).Value())
+	fmt.Println(From([]int{1, 2, 3, 4, 5}).Drop(1).Chunk(2))
+	// Output:
+	// [1 3 3 5 7]
+	// [4 3 2]
+	// [[1] [2 3]]
+	// [[2 3] [4 5]]
+}
+
+func ExampleMapChain() {
+	words := UniqChain(From([]string{"go", "is", "fun", "go"}))
+	lengths := MapChain(words, func(s string) int { return len(s) })
+	fmt.Println(UniqChain(lengths).Value())
+	// Output:
+	// [2 3]
+}
+
+func ExampleFlatMapChain() {
+	letters := FlatMapChain(From([]string{"ab", "bc"}), func(s string, _ int, _ []string) []string {
+		return strings.Split(s, "")
+	})
+	fmt.Println(UniqChain(letters).Len(), letters.Value())
+	// Output:
+	// 3 [a b b c]
+}
+
+func ExampleUniqChain() {
+	fmt.Println(UniqChain(From([]int{2, 1, 2}).Reverse()).Value())
+	// Output:
+	// [2 1]
+}
+
+func ExampleUniqByChain() {
+	type order struct {
+		customer string
+		items    []string
+	}
+	orders := From([]order{{"a", []string{"x"}}, {"b", nil}, {"a", []string{"y"}}})
+	fmt.Println(UniqByChain(orders, func(o order) string { return o.customer }).Len())
+	// Output:
+	// 2
+}
+
+func ExampleWithoutChain() {
+	fmt.Println(WithoutChain(From([]int{1, 2, 3, 4}).Reverse(), 3).Value())
+	// Output:
+	// [4 2 1]
+}
+
+func ExampleUnionChain() {
+	fmt.Println(DifferenceChain(UnionChain(From([]int{1, 2, 3}), []int{3, 4}), []int{1}).Value())
+	// Output:
+	// [2 3 4]
+}
+
+func ExampleIntersectionChain() {
+	fmt.Println(IntersectionChain(From([]int{2, 1, 2}), []int{2, 3}).Value())
+	// Output:
+	// [2]
+}
+
+func ExampleDifferenceChain() {
+	fmt.Println(DifferenceChain(From([]int{1, 2, 3, 4}), []int{1}, []int{4}).Value())
+	// Output:
+	// [2 3]
+}
+
+func ExampleXorChain() {
+	fmt.Println(XorChain(From([]int{2, 3, 4}), []int{4, 5}).Value())
+	fmt.Println(XorChain(From([]int{1}), []int{1}, []int{2}).Value())
+	// Output:
+	// [2 3 5]
+	// [1 2]
+}
@@ -0,0 +1,40 @@
+package slicy
+
+import (
+	"github.com/sudhirj/slicy/seq"
+)
+
+// CartesianProduct returns every combination made by picking one element from each of the given slices,
+// in the order of nested loops with the last slice varying fastest. With no slices it returns a single
+// empty slice, and if any slice is empty it returns no combinations. See `seq.CartesianProduct` for a lazy
+// version.
+func CartesianProduct[S ~[]T, T any](slices ...S) []S {
+	return seq.Collect(seq.CartesianProduct(slices...))
+}
+
+// Combinations returns every way of choosing `k` elements from `slice`, keeping their order from `slice`.
+// A `k` of 0 gives a single empty slice, and a `k` that is negative or larger than `slice` gives none.
+// See `seq.Combinations` for a lazy version.
+func Combinations[S ~[]T, T any](slice S, k int) []S {
+	return seq.Collect(seq.Combinations(slice, k))
+}
+
+// CombinationsWithReplacement is like `Combinations`, but allows each element to be chosen more than once.
+// See `seq.CombinationsWithReplacement` for a lazy version.
+func CombinationsWithReplacement[S ~[]T, T any](slice S, k int) []S {
+	return seq.Collect(seq.CombinationsWithReplacement(slice, k))
+}
+
+// Permutations returns every ordered arrangement of `k` distinct elements of `slice`. Elements are
+// distinguished by position, not value, so duplicates in `slice` give repeated permutations. A `k` of 0
+// gives a single empty slice, and a `k` that is negative or larger than `slice` gives none. See
+// `seq.Permutations` for a lazy version.
+func Permutations[S ~[]T, T any](slice S, k int) []S {
+	return seq.Collect(seq.Permutations(slice, k))
+}
+
+// PowerSet returns every subset of `slice` in order of size, keeping the order of elements from `slice`.
+// See `seq.PowerSet` for a lazy version.
+func PowerSet[S ~[]T, T any](slice S) []S {
+	return seq.Collect(seq.PowerSet(slice))
+}
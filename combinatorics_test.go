@@ -0,0 +1,68 @@
+package slicy
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestCombinatoricsCounts(t *testing.T) {
+	input := []int{1, 2, 3, 4, 5}
+	tests := []struct {
+		name string
+		o    int
+		n    int
+	}{
+		{"permutations", len(Permutations(input, 3)), 60},
+		{"all permutations", len(Permutations(input, 5)), 120},
+		{"combinations", len(Combinations(input, 2)), 10},
+		{"combinations with replacement", len(CombinationsWithReplacement(input, 3)), 35},
+		{"power set", len(PowerSet(input)), 32},
+		{"cartesian product", len(CartesianProduct(input, input, []int{1, 2})), 50},
+		{"too many", len(Combinations(input, 6)), 0},
+		{"negative", len(Permutations(input, -1)), 0},
+		{"empty product", len(CartesianProduct(input, []int{})), 0},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.o != test.n {
+				t.Error(test.n, test.o)
+			}
+		})
+	}
+}
+
+func ExampleCartesianProduct() {
+	fmt.Println(CartesianProduct([]string{"a", "b"}, []string{"x", "y", "z"}))
+	fmt.Println(CartesianProduct[[]int]())
+	// Output:
+	// [[a x] [a y] [a z] [b x] [b y] [b z]]
+	// [[]]
+}
+
+func ExampleCombinations() {
+	fmt.Println(Combinations([]string{"a", "b", "c", "d"}, 2))
+	fmt.Println(Combinations([]string{"a", "b"}, 0))
+	// Output:
+	// [[a b] [a c] [a d] [b c] [b d] [c d]]
+	// [[]]
+}
+
+func ExampleCombinationsWithReplacement() {
+	fmt.Println(CombinationsWithReplacement([]string{"a", "b", "c"}, 2))
+	// Output:
+	// [[a a] [a b] [a c] [b b] [b c] [c c]]
+}
+
+func ExamplePermutations() {
+	fmt.Println(Permutations([]int{1, 2, 3}, 2))
+	fmt.Println(Permutations([]int{1, 2, 3}, 3))
+	// Output:
+	// [[1 2] [1 3] [2 1] [2 3] [3 1] [3 2]]
+	// [[1 2 3] [1 3 2] [2 1 3] [2 3 1] [3 1 2] [3 2 1]]
+}
+
+func ExamplePowerSet() {
+	fmt.Println(PowerSet([]int{1, 2, 3}))
+	// Output:
+	// [[] [1] [2] [3] [1 2] [1 3] [2 3] [1 2 3]]
+}
@@ -0,0 +1,192 @@
+package slicy
+
+import (
+	"context"
+	"runtime"
+)
+
+// The `...Ctx` functions are the context-aware members of the `...Err` family: they take callbacks that
+// can fail, stop at the first error, and also stop as soon as `ctx` is done, returning `ctx.Err()` as is.
+// There are deliberately no context-aware versions of the `...ErrAll` functions, since carrying on past
+// every error and stopping early on cancellation pull in opposite directions; check `ctx` inside the
+// callback and return its error to get both.
+
+// EachCtx invokes the given `iteratee` for every element in the slice, from left to right, checking `ctx`
+// before each element. It stops at the first error returned by `iteratee`, which is returned as an
+// `*IndexError`, or returns `ctx.Err()` as soon as `ctx` is done.
+func EachCtx[S ~[]T, T any](ctx context.Context, slice S, iteratee func(value T, index int, slice S) error) error {
+	return eachErr(ctx, len(slice), false, func(i int) error { return iteratee(slice[i], i, slice) })
+}
+
+// EveryCtx returns true if the given `predicate` returns true for every element of the given slice, checking
+// `ctx` before each element. It stops at the first error returned by `predicate`, which is returned as an
+// `*IndexError`, or returns `ctx.Err()` as soon as `ctx` is done. The result is false whenever an error is returned.
+func EveryCtx[S ~[]T, T any](ctx context.Context, slice S, predicate func(value T, index int, slice S) (bool, error)) (bool, error) {
+	found, err := SomeCtx(ctx, slice, negateErr(predicate))
+	return !found && err == nil, err
+}
+
+// FilterCtx returns a slice of all elements that the `predicate` returns true for, checking `ctx` before
+// each element. It stops at the first error returned by `predicate`, which is returned as an `*IndexError`,
+// or returns `ctx.Err()` as soon as `ctx` is done. The elements kept so far are returned along with the error.
+func FilterCtx[S ~[]T, T any](ctx context.Context, slice S, predicate func(value T, index int, slice S) (bool, error)) (S, error) {
+	return filterErr(ctx, slice, false, predicate)
+}
+
+// FindCtx returns the first element that `predicate` returns true for, checking `ctx` before each element.
+// It stops at the first error returned by `predicate`, which is returned as an `*IndexError`, or returns
+// `ctx.Err()` as soon as `ctx` is done. The zero value is returned along with an error.
+func FindCtx[S ~[]T, T any](ctx context.Context, slice S, predicate func(value T, index int, slice S) (bool, error)) (result T, err error) {
+	result, _, err = findErr(ctx, slice, predicate)
+	return
+}
+
+// FlatMapCtx creates a flattened slice of values by running each element in `slice` through `iteratee`,
+// checking `ctx` before each element. It stops at the first error returned by `iteratee`, which is returned
+// as an `*IndexError`, or returns `ctx.Err()` as soon as `ctx` is done. The values flattened so far are
+// returned along with the error.
+func FlatMapCtx[S ~[]T, T any, U any](ctx context.Context, slice S, iteratee func(value T, index int, slice S) ([]U, error)) ([]U, error) {
+	return flatMapErr(ctx, slice, false, iteratee)
+}
+
+// GroupByCtx is like `GroupBy`, but checks `ctx` before each element. It stops at the first error returned by
+// `iteratee`, which is returned as an `*IndexError`, or returns `ctx.Err()` as soon as `ctx` is done. The
+// groups built so far are returned along with the error.
+func GroupByCtx[S ~[]T, T any, U comparable](ctx context.Context, slice S, iteratee func(T) (U, error)) (map[U]S, error) {
+	return groupByErr(ctx, slice, iteratee)
+}
+
+// KeyByCtx is like `KeyBy`, but checks `ctx` before each element. It stops at the first error returned by
+// `iteratee`, which is returned as an `*IndexError`, or returns `ctx.Err()` as soon as `ctx` is done. The
+// keys built so far are returned along with the error.
+func KeyByCtx[S ~[]T, T any, U comparable](ctx context.Context, slice S, iteratee func(T) (U, error)) (map[U]T, error) {
+	return keyByErr(ctx, slice, iteratee)
+}
+
+// MapCtx creates a slice of values by running each element in `slice` through `iteratee`, checking `ctx`
+// before each element. It stops at the first error returned by `iteratee`, which is returned as an
+// `*IndexError`, or returns `ctx.Err()` as soon as `ctx` is done. The values mapped so far are returned
+// along with the error.
+func MapCtx[S ~[]T, T any, U any](ctx context.Context, slice S, iteratee func(T) (U, error)) ([]U, error) {
+	return mapErr(ctx, slice, iteratee)
+}
+
+// ReduceCtx is like `Reduce`, but checks `ctx` before each element. It stops at the first error returned by
+// `iteratee`, which is returned as an `*IndexError`, or returns `ctx.Err()` as soon as `ctx` is done.
+// The value accumulated so far is returned along with the error.
+func ReduceCtx[S ~[]T, T any, U any](ctx context.Context, slice S, iteratee func(acc U, value T, index int, slice S) (U, error), accumulator U) (U, error) {
+	return reduceErr(ctx, slice, iteratee, accumulator)
+}
+
+// RejectCtx returns a slice of all elements that the `predicate` returns false for, checking `ctx` before
+// each element. It stops at the first error returned by `predicate`, which is returned as an `*IndexError`,
+// or returns `ctx.Err()` as soon as `ctx` is done. The elements kept so far are returned along with the error.
+func RejectCtx[S ~[]T, T any](ctx context.Context, slice S, predicate func(value T, index int, slice S) (bool, error)) (S, error) {
+	return filterErr(ctx, slice, false, negateErr(predicate))
+}
+
+// SomeCtx returns true if the given `predicate` returns true for any element of the given slice, checking
+// `ctx` before each element. It stops at the first error returned by `predicate`, which is returned as an
+// `*IndexError`, or returns `ctx.Err()` as soon as `ctx` is done. The result is false whenever an error is returned.
+func SomeCtx[S ~[]T, T any](ctx context.Context, slice S, predicate func(value T, index int, slice S) (bool, error)) (bool, error) {
+	_, index, err := findErr(ctx, slice, predicate)
+	return index != -1 && err == nil, err
+}
+
+// ParallelEachCtx is like `ParallelEach`, but stops handing out elements once `iteratee` returns an error or
+// `ctx` is done. Of the errors returned by `iteratee`, the one for the lowest index is returned as an
+// `*IndexError`; if `ctx` ended the iteration early `ctx.Err()` is returned.
+func ParallelEachCtx[S ~[]T, T any](ctx context.Context, slice S, concurrency int, iteratee func(value T, index int, slice S) error) error {
+	return parallelIndexesErr(ctx, len(slice), concurrency, func(i int) error {
+		return iteratee(slice[i], i, slice)
+	})
+}
+
+// ParallelFilterCtx is like `ParallelFilter`, but stops handing out elements once `predicate` returns an
+// error or `ctx` is done. Of the errors returned by `predicate`, the one for the lowest index is returned as
+// an `*IndexError`; if `ctx` ended the iteration early `ctx.Err()` is returned. The elements kept so far are
+// returned along with the error, in input order, though they may not be contiguous since elements are
+// processed concurrently.
+func ParallelFilterCtx[S ~[]T, T any](ctx context.Context, slice S, concurrency int, predicate func(value T, index int, slice S) (bool, error)) (S, error) {
+	keep := make([]bool, len(slice))
+	err := parallelIndexesErr(ctx, len(slice), concurrency, func(i int) error {
+		ok, err := predicate(slice[i], i, slice)
+		keep[i] = ok && err == nil
+		return err
+	})
+	output := make(S, 0)
+	for i, item := range slice {
+		if keep[i] {
+			output = append(output, item)
+		}
+	}
+	return output, err
+}
+
+// ParallelMapCtx is like `ParallelMap`, but stops handing out elements once `iteratee` returns an error or
+// `ctx` is done. Of the errors returned by `iteratee`, the one for the lowest index is returned as an
+// `*IndexError`; if `ctx` ended the iteration early `ctx.Err()` is returned. The values mapped so far are
+// returned along with the error, in input order, though they may not be contiguous since elements are
+// processed concurrently.
+func ParallelMapCtx[S ~[]T, T any, U any](ctx context.Context, slice S, concurrency int, iteratee func(T) (U, error)) ([]U, error) {
+	output := make([]U, len(slice))
+	mapped := make([]bool, len(slice))
+	err := parallelIndexesErr(ctx, len(slice), concurrency, func(i int) (err error) {
+		output[i], err = iteratee(slice[i])
+		mapped[i] = err == nil
+		return
+	})
+	if err != nil {
+		return Filter(output, func(_ U, i int, _ []U) bool { return mapped[i] }), err
+	}
+	return output, nil
+}
+
+// ParallelReduceCtx is like `ParallelReduce`, but stops once `iteratee` returns an error or `ctx` is done,
+// which is checked before each element. Of the errors returned by `iteratee`, the one for the lowest index
+// is returned as an `*IndexError`; if `ctx` ended the reduction early `ctx.Err()` is returned. The value
+// accumulated so far is returned along with the error, combining what each part had reduced when it stopped.
+func ParallelReduceCtx[S ~[]T, T any, U any](ctx context.Context, slice S, concurrency int, iteratee func(acc U, value T, index int, slice S) (U, error), combine func(U, U) U, accumulator U) (result U, err error) {
+	if len(slice) == 0 {
+		return accumulator, nil
+	}
+	if concurrency < 1 {
+		concurrency = runtime.GOMAXPROCS(0)
+	}
+	size := (len(slice) + concurrency - 1) / concurrency
+	parts := make([]U, (len(slice)+size-1)/size)
+	for p := range parts {
+		parts[p] = accumulator
+	}
+	// failures holds the error of each part, so that the one for the lowest index can be picked
+	failures := make([]error, len(parts))
+	err = parallelIndexesErr(ctx, len(parts), len(parts), func(p int) error {
+		start := p * size
+		end := min(start+size, len(slice))
+		for i := start; i < end; i++ {
+			if ctx.Err() != nil {
+				return errStop
+			}
+			next, err := iteratee(parts[p], slice[i], i, slice)
+			if err != nil {
+				failures[p] = &IndexError{Index: i, Err: err}
+				return errStop
+			}
+			parts[p] = next
+		}
+		return nil
+	})
+	result = parts[0]
+	for _, part := range parts[1:] {
+		result = combine(result, part)
+	}
+	for _, failure := range failures {
+		if failure != nil {
+			return result, failure
+		}
+	}
+	if err != nil {
+		return result, ctx.Err()
+	}
+	return result, nil
+}
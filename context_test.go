@@ -0,0 +1,247 @@
+package slicy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestMapCtxCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	op, err := MapCtx(ctx, []int{1, 2, 3, 4}, func(v int) (int, error) {
+		if v == 2 {
+			cancel()
+		}
+		return v * 10, nil
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Error("expected context.Canceled, got", err)
+	}
+	if fmt.Sprint(op) != "[10 20]" {
+		t.Error("expected partial result [10 20], got", op)
+	}
+}
+
+func TestCtxReturnsContextErrorUnwrapped(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	input := []int{1, 2, 3}
+	pass := func(int, int, []int) (bool, error) { return true, nil }
+	identity := func(v int) (int, error) { return v, nil }
+	sum := func(acc int, v int, _ int, _ []int) (int, error) { return acc + v, nil }
+	tests := []struct {
+		name string
+		op   func() error
+	}{
+		{"EachCtx", func() error { return EachCtx(ctx, input, func(int, int, []int) error { return nil }) }},
+		{"EveryCtx", func() error { _, err := EveryCtx(ctx, input, pass); return err }},
+		{"FilterCtx", func() error { _, err := FilterCtx(ctx, input, pass); return err }},
+		{"FindCtx", func() error { _, err := FindCtx(ctx, input, pass); return err }},
+		{"FlatMapCtx", func() error {
+			_, err := FlatMapCtx(ctx, input, func(v int, _ int, _ []int) ([]int, error) { return []int{v}, nil })
+			return err
+		}},
+		{"GroupByCtx", func() error { _, err := GroupByCtx(ctx, input, identity); return err }},
+		{"KeyByCtx", func() error { _, err := KeyByCtx(ctx, input, identity); return err }},
+		{"MapCtx", func() error { _, err := MapCtx(ctx, input, identity); return err }},
+		{"ReduceCtx", func() error { _, err := ReduceCtx(ctx, input, sum, 0); return err }},
+		{"RejectCtx", func() error { _, err := RejectCtx(ctx, input, pass); return err }},
+		{"SomeCtx", func() error { _, err := SomeCtx(ctx, input, pass); return err }},
+		{"ParallelEachCtx", func() error {
+			return ParallelEachCtx(ctx, input, 2, func(int, int, []int) error { return nil })
+		}},
+		{"ParallelFilterCtx", func() error { _, err := ParallelFilterCtx(ctx, input, 2, pass); return err }},
+		{"ParallelMapCtx", func() error { _, err := ParallelMapCtx(ctx, input, 2, identity); return err }},
+		{"ParallelReduceCtx", func() error {
+			_, err := ParallelReduceCtx(ctx, input, 2, sum, func(a, b int) int { return a + b }, 0)
+			return err
+		}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if err := test.op(); err != context.Canceled {
+				t.Error(context.Canceled, err)
+			}
+		})
+	}
+}
+
+func TestParallelReduceCtx(t *testing.T) {
+	input := make([]int, 1000)
+	for i := range input {
+		input[i] = i + 1
+	}
+	add := func(a, b int) int { return a + b }
+	sum := func(acc int, v int, _ int, _ []int) (int, error) {
+		if v == 400 || v == 900 {
+			return acc, errors.New("unlucky")
+		}
+		return acc + v, nil
+	}
+	op, err := ParallelReduceCtx(context.Background(), input, 4, sum, add, 0)
+	var indexErr *IndexError
+	if !errors.As(err, &indexErr) || indexErr.Index != 399 {
+		t.Error("expected an *IndexError at 399, got", err)
+	}
+	// the second part always sums 251 to 399 before failing, and the others may or may not have run
+	if op < 48425 || op >= 500500 {
+		t.Error("unexpected partial result", op)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stopAt3 := func(acc int, v int, _ int, _ []int) (int, error) {
+		if v == 3 {
+			cancel()
+		}
+		return acc + v, nil
+	}
+	if op, err := ParallelReduceCtx(ctx, input, 1, stopAt3, add, 0); op != 6 || err != context.Canceled {
+		t.Error(6, context.Canceled, op, err)
+	}
+	if op, err := ParallelReduceCtx(context.Background(), input[:399], 4, sum, add, 0); op != 79800 || err != nil {
+		t.Error(79800, op, err)
+	}
+}
+
+func TestParallelMapCtx(t *testing.T) {
+	input := make([]string, 100)
+	for i := range input {
+		input[i] = strconv.Itoa(i)
+	}
+	input[40], input[70] = "x", "y"
+	op, err := ParallelMapCtx(context.Background(), input, 4, strconv.Atoi)
+	var indexErr *IndexError
+	if !errors.As(err, &indexErr) {
+		t.Fatal("expected an *IndexError, got", err)
+	}
+	if indexErr.Index != 40 && indexErr.Index != 70 {
+		t.Error("unexpected index", indexErr.Index)
+	}
+	// the values mapped so far come back in input order, without the ones that failed
+	for i := 1; i < len(op); i++ {
+		if op[i] <= op[i-1] || op[i] == 40 || op[i] == 70 {
+			t.Fatal("unexpected partial output", op)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stopAt2 := func(v int) (int, error) {
+		if v == 2 {
+			cancel()
+		}
+		return v * 10, nil
+	}
+	if op, err := ParallelMapCtx(ctx, []int{1, 2, 3}, 1, stopAt2); !reflect.DeepEqual(op, []int{10, 20}) || err != context.Canceled {
+		t.Error([]int{10, 20}, context.Canceled, op, err)
+	}
+}
+
+func TestParallelFilterCtx(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	even := func(v int, _ int, _ []int) (bool, error) {
+		if v == 4 {
+			cancel()
+		}
+		return v%2 == 0, nil
+	}
+	if op, err := ParallelFilterCtx(ctx, []int{1, 2, 3, 4, 5, 6}, 1, even); !reflect.DeepEqual(op, []int{2, 4}) || err != context.Canceled {
+		t.Error([]int{2, 4}, context.Canceled, op, err)
+	}
+}
+
+func ExampleEachCtx() {
+	ctx, cancel := context.WithCancel(context.Background())
+	err := EachCtx(ctx, []int{1, 2, 3}, func(v int, _ int, _ []int) error {
+		fmt.Println(v)
+		if v == 2 {
+			cancel()
+		}
+		return nil
+	})
+	fmt.Println(err)
+	// Output:
+	// 1
+	// 2
+	// context canceled
+}
+
+func ExampleFilterCtx() {
+	fmt.Println(FilterCtx(context.Background(), []int{1, 2, 3, 4}, func(v int, _ int, _ []int) (bool, error) {
+		return v%2 == 0, nil
+	}))
+	// Output:
+	// [2 4] <nil>
+}
+
+func ExampleMapCtx() {
+	fmt.Println(MapCtx(context.Background(), []string{"1", "2"}, strconv.Atoi))
+	fmt.Println(MapCtx(context.Background(), []string{"1", "x"}, strconv.Atoi))
+	// Output:
+	// [1 2] <nil>
+	// [1] index 1: strconv.Atoi: parsing "x": invalid syntax
+}
+
+func ExampleReduceCtx() {
+	ctx, cancel := context.WithCancel(context.Background())
+	fmt.Println(ReduceCtx(ctx, []int{1, 2, 3}, func(acc int, v int, _ int, _ []int) (int, error) {
+		if v == 2 {
+			cancel()
+		}
+		return acc + v, nil
+	}, 0))
+	// Output:
+	// 3 context canceled
+}
+
+func ExampleParallelEachCtx() {
+	err := ParallelEachCtx(context.Background(), []string{"1", "x"}, 2, func(v string, _ int, _ []string) error {
+		_, err := strconv.Atoi(v)
+		return err
+	})
+	fmt.Println(err)
+	// Output:
+	// index 1: strconv.Atoi: parsing "x": invalid syntax
+}
+
+func ExampleParallelFilterCtx() {
+	fmt.Println(ParallelFilterCtx(context.Background(), []int{1, 2, 3, 4}, 2, func(v int, _ int, _ []int) (bool, error) {
+		return v%2 == 0, nil
+	}))
+	// Output:
+	// [2 4] <nil>
+}
+
+func ExampleFindCtx() {
+	fmt.Println(FindCtx(context.Background(), []string{"1", "22", "333"}, func(v string, _ int, _ []string) (bool, error) {
+		return len(v) > 1, nil
+	}))
+	// Output:
+	// 22 <nil>
+}
+
+func ExampleGroupByCtx() {
+	fmt.Println(GroupByCtx(context.Background(), []string{"1", "2", "3"}, func(v string) (bool, error) {
+		n, err := strconv.Atoi(v)
+		return n%2 == 0, err
+	}))
+	// Output:
+	// map[false:[1 3] true:[2]] <nil>
+}
+
+func ExampleParallelReduceCtx() {
+	fmt.Println(ParallelReduceCtx(context.Background(), []string{"1", "2", "3", "4"}, 2, func(acc int, v string, _ int, _ []string) (int, error) {
+		n, err := strconv.Atoi(v)
+		return acc + n, err
+	}, func(a, b int) int { return a + b }, 0))
+	// Output:
+	// 10 <nil>
+}
+
+func ExampleParallelMapCtx() {
+	fmt.Println(ParallelMapCtx(context.Background(), []string{"1", "2", "3"}, 2, strconv.Atoi))
+	// Output:
+	// [1 2 3] <nil>
+}
@@ -0,0 +1,263 @@
+package slicy
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// EditKind is the kind of operation in an edit script produced by `Diff`.
+type EditKind int
+
+const (
+	// EditEqual keeps an element that is present in both slices.
+	EditEqual EditKind = iota
+	// EditDelete removes an element of the first slice.
+	EditDelete
+	// EditInsert adds an element of the second slice.
+	EditInsert
+)
+
+func (k EditKind) String() string {
+	switch k {
+	case EditEqual:
+		return "equal"
+	case EditDelete:
+		return "delete"
+	case EditInsert:
+		return "insert"
+	}
+	return fmt.Sprintf("EditKind(%d)", int(k))
+}
+
+// Edit is a single operation in an edit script that turns slice `a` into slice `b`.
+// `AIndex` and `BIndex` are the positions in `a` and `b` the operation applies to: for an
+// `EditDelete`, `BIndex` is where the element would have been in `b`, and for an `EditInsert`,
+// `AIndex` is the position in `a` before which the element is inserted.
+type Edit[T any] struct {
+	Kind   EditKind
+	AIndex int
+	BIndex int
+	Value  T
+}
+
+// ErrInvalidEditScript is returned by `Patch` when an edit script does not fit the slice it is applied to.
+var ErrInvalidEditScript = errors.New("slicy: edit script does not match slice")
+
+// Diff returns the shortest edit script of `EditEqual`, `EditDelete` and `EditInsert` operations that
+// turns `a` into `b`, using Myers' algorithm. Equality is checked with `==`.
+func Diff[S ~[]T, T comparable](a, b S) []Edit[T] {
+	return diff(a, b, func(i, j int) bool { return a[i] == b[j] })
+}
+
+// DiffBy is like `Diff`, but checks equality with `==` on the result of passing elements through
+// `iteratee`, which is called once per element.
+func DiffBy[S ~[]T, T any, U comparable](a, b S, iteratee func(T) U) []Edit[T] {
+	aKeys, bKeys := Map(a, iteratee), Map(b, iteratee)
+	return diff(a, b, func(i, j int) bool { return aKeys[i] == bKeys[j] })
+}
+
+// DiffWith is like `Diff`, but checks equality using the given `comparator`.
+func DiffWith[S ~[]T, T any](a, b S, comparator func(T, T) bool) []Edit[T] {
+	return diff(a, b, func(i, j int) bool { return comparator(a[i], b[j]) })
+}
+
+// diff runs the linear space variant of Myers' algorithm, with `equal` comparing the element at index
+// `i` of `a` to the one at index `j` of `b`.
+func diff[S ~[]T, T any](a, b S, equal func(i, j int) bool) []Edit[T] {
+	d := &differ[S, T]{a: a, b: b, equal: equal, output: make([]Edit[T], 0)}
+	d.compare(0, len(a), 0, len(b))
+	return d.output
+}
+
+// differ accumulates the edit script for `a` and `b`, in order.
+type differ[S ~[]T, T any] struct {
+	a, b   S
+	equal  func(i, j int) bool
+	output []Edit[T]
+}
+
+// compare appends the edits that turn `a[aLo:aHi]` into `b[bLo:bHi]`, splitting the problem in two at
+// the middle of a shortest edit path until only insertions or deletions remain.
+func (d *differ[S, T]) compare(aLo, aHi, bLo, bHi int) {
+	for aLo < aHi && bLo < bHi && d.equal(aLo, bLo) {
+		d.output = append(d.output, Edit[T]{EditEqual, aLo, bLo, d.a[aLo]})
+		aLo, bLo = aLo+1, bLo+1
+	}
+	suffix := 0
+	for aLo < aHi-suffix && bLo < bHi-suffix && d.equal(aHi-suffix-1, bHi-suffix-1) {
+		suffix++
+	}
+	aHi, bHi = aHi-suffix, bHi-suffix
+
+	if x, y, ok := d.bisect(aLo, aHi, bLo, bHi); ok {
+		d.compare(aLo, x, bLo, y)
+		d.compare(x, aHi, y, bHi)
+	} else {
+		for i := aLo; i < aHi; i++ {
+			d.output = append(d.output, Edit[T]{EditDelete, i, bLo, d.a[i]})
+		}
+		for j := bLo; j < bHi; j++ {
+			d.output = append(d.output, Edit[T]{EditInsert, aHi, j, d.b[j]})
+		}
+	}
+
+	for i := 0; i < suffix; i++ {
+		d.output = append(d.output, Edit[T]{EditEqual, aHi + i, bHi + i, d.a[aHi+i]})
+	}
+}
+
+// bisect searches for the shortest edit path from both ends of `a[aLo:aHi]` and `b[bLo:bHi]` at once,
+// keeping only the furthest reaching point of each diagonal, and returns the point where the two
+// searches meet. It returns false if either range is empty or the ranges have nothing in common, in
+// which case the shortest script simply deletes all of one and inserts all of the other. The ranges
+// must not start or end with equal elements.
+func (d *differ[S, T]) bisect(aLo, aHi, bLo, bHi int) (x, y int, ok bool) {
+	n, m := aHi-aLo, bHi-bLo
+	if n == 0 || m == 0 {
+		return 0, 0, false
+	}
+	maxD := (n + m + 1) / 2
+	offset := maxD
+	// forward[offset+k] and backward[offset+k] hold the furthest x reached on diagonal k from each end
+	forward, backward := make([]int, 2*maxD+2), make([]int, 2*maxD+2)
+	for i := range forward {
+		forward[i], backward[i] = -1, -1
+	}
+	forward[offset+1], backward[offset+1] = 0, 0
+	delta := n - m
+	// when delta is odd the paths meet while searching forwards, otherwise while searching backwards
+	front := delta%2 != 0
+	// diagonals that have run off the edge of the grid are skipped
+	kfStart, kfEnd, kbStart, kbEnd := 0, 0, 0, 0
+	for step := 0; step < maxD; step++ {
+		for k := -step + kfStart; k <= step-kfEnd; k += 2 {
+			var fx int
+			if k == -step || (k != step && forward[offset+k-1] < forward[offset+k+1]) {
+				fx = forward[offset+k+1]
+			} else {
+				fx = forward[offset+k-1] + 1
+			}
+			fy := fx - k
+			for fx < n && fy < m && d.equal(aLo+fx, bLo+fy) {
+				fx, fy = fx+1, fy+1
+			}
+			forward[offset+k] = fx
+			switch {
+			case fx > n:
+				kfEnd += 2
+			case fy > m:
+				kfStart += 2
+			case front:
+				if kb := offset + delta - k; kb >= 0 && kb < len(backward) && backward[kb] != -1 && fx >= n-backward[kb] {
+					return aLo + fx, bLo + fy, true
+				}
+			}
+		}
+		for k := -step + kbStart; k <= step-kbEnd; k += 2 {
+			var bx int
+			if k == -step || (k != step && backward[offset+k-1] < backward[offset+k+1]) {
+				bx = backward[offset+k+1]
+			} else {
+				bx = backward[offset+k-1] + 1
+			}
+			by := bx - k
+			for bx < n && by < m && d.equal(aHi-bx-1, bHi-by-1) {
+				bx, by = bx+1, by+1
+			}
+			backward[offset+k] = bx
+			switch {
+			case bx > n:
+				kbEnd += 2
+			case by > m:
+				kbStart += 2
+			case !front:
+				if kf := offset + delta - k; kf >= 0 && kf < len(forward) && forward[kf] != -1 {
+					fx := forward[kf]
+					if fx >= n-bx {
+						return aLo + fx, bLo + fx - (kf - offset), true
+					}
+				}
+			}
+		}
+	}
+	return 0, 0, false
+}
+
+// Patch applies an edit script from `Diff`, `DiffBy` or `DiffWith` to `a`, returning a new slice.
+// Elements kept by `EditEqual` operations are taken from `a`, and inserted ones from the script.
+// Returns `ErrInvalidEditScript` if the script's positions in `a` don't line up with `a`.
+func Patch[S ~[]T, T any](a S, script []Edit[T]) (S, error) {
+	output := make(S, 0, len(a))
+	cursor := 0
+	for i, edit := range script {
+		switch edit.Kind {
+		case EditEqual, EditDelete:
+			if edit.AIndex != cursor || cursor >= len(a) {
+				return nil, fmt.Errorf("%w: edit %d is at index %d of a, expected %d", ErrInvalidEditScript, i, edit.AIndex, cursor)
+			}
+			if edit.Kind == EditEqual {
+				output = append(output, a[cursor])
+			}
+			cursor++
+		case EditInsert:
+			if edit.AIndex != cursor {
+				return nil, fmt.Errorf("%w: edit %d is at index %d of a, expected %d", ErrInvalidEditScript, i, edit.AIndex, cursor)
+			}
+			output = append(output, edit.Value)
+		default:
+			return nil, fmt.Errorf("%w: edit %d has unknown kind %v", ErrInvalidEditScript, i, edit.Kind)
+		}
+	}
+	if cursor != len(a) {
+		return nil, fmt.Errorf("%w: script ends at index %d of a, which has length %d", ErrInvalidEditScript, cursor, len(a))
+	}
+	return output, nil
+}
+
+// UnifiedDiff renders an edit script in the style of a unified diff, with one line per element formatted
+// with `fmt.Sprint` like `Join`, prefixed by ` `, `-` or `+`. Changes are grouped into hunks with up to
+// `context` unchanged elements around them, each introduced by a `@@ -start,length +start,length @@`
+// header with 1-based line numbers. An edit script without changes renders as an empty string.
+func UnifiedDiff[T any](script []Edit[T], context int) string {
+	if context < 0 {
+		context = 0
+	}
+	var sb strings.Builder
+	for i := 0; i < len(script); {
+		if script[i].Kind == EditEqual {
+			i++
+			continue
+		}
+		start, end := max(i-context, 0), i
+		for j := i; j < len(script); j++ {
+			if script[j].Kind != EditEqual {
+				end = j + 1
+			} else if j-end+1 > 2*context {
+				break
+			}
+		}
+		end = min(end+context, len(script))
+		writeHunk(&sb, script[start:end])
+		i = end
+	}
+	return sb.String()
+}
+
+func writeHunk[T any](sb *strings.Builder, hunk []Edit[T]) {
+	aLength := len(Filter(hunk, func(e Edit[T], _ int, _ []Edit[T]) bool { return e.Kind != EditInsert }))
+	bLength := len(Filter(hunk, func(e Edit[T], _ int, _ []Edit[T]) bool { return e.Kind != EditDelete }))
+	// like diff(1), an empty range is numbered by the line before it
+	aStart, bStart := hunk[0].AIndex, hunk[0].BIndex
+	if aLength > 0 {
+		aStart++
+	}
+	if bLength > 0 {
+		bStart++
+	}
+	fmt.Fprintf(sb, "@@ -%d,%d +%d,%d @@\n", aStart, aLength, bStart, bLength)
+	prefixes := map[EditKind]string{EditEqual: " ", EditDelete: "-", EditInsert: "+"}
+	lines := Map(hunk, func(e Edit[T]) string { return prefixes[e.Kind] + fmt.Sprint(e.Value) })
+	sb.WriteString(Join(lines, "\n"))
+	sb.WriteString("\n")
+}
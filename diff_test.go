@@ -0,0 +1,143 @@
+package slicy
+
+import (
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func TestDiffPatchRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewPCG(7, 8))
+	for i := 0; i < 1000; i++ {
+		a := make([]int, rng.IntN(40))
+		b := make([]int, rng.IntN(40))
+		alphabet := 1 + rng.IntN(8)
+		for j := range a {
+			a[j] = rng.IntN(alphabet)
+		}
+		for j := range b {
+			b[j] = rng.IntN(alphabet)
+		}
+		script := Diff(a, b)
+		op, err := Patch(a, script)
+		if err != nil || !reflect.DeepEqual(op, b) {
+			t.Fatal(a, b, script, op, err)
+		}
+		for _, edit := range script {
+			if edit.Kind != EditInsert && a[edit.AIndex] != edit.Value || edit.Kind != EditDelete && b[edit.BIndex] != edit.Value {
+				t.Fatal("wrong indexes", a, b, edit)
+			}
+		}
+		// the number of changes is minimal when the kept elements form a longest common subsequence
+		kept := len(Filter(script, func(e Edit[int], _ int, _ []Edit[int]) bool { return e.Kind == EditEqual }))
+		if kept != lcsLength(a, b) {
+			t.Fatal("not a shortest edit script", a, b, script)
+		}
+	}
+}
+
+func TestDiffLargeMostlyDifferent(t *testing.T) {
+	rng := rand.New(rand.NewPCG(9, 10))
+	a, b := make([]int, 4000), make([]int, 4000)
+	for i := range a {
+		a[i], b[i] = rng.IntN(1000), 1000+rng.IntN(1000)
+	}
+	// a few shared elements, so the script is not just a delete of everything and an insert of everything
+	for i := 0; i < len(a); i += 500 {
+		b[i+7] = a[i]
+	}
+
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	script := Diff(a, b)
+	runtime.ReadMemStats(&after)
+
+	if op, err := Patch(a, script); err != nil || !reflect.DeepEqual(op, b) {
+		t.Fatal("patch did not recreate b", err)
+	}
+	kept := len(Filter(script, func(e Edit[int], _ int, _ []Edit[int]) bool { return e.Kind == EditEqual }))
+	if kept != 8 {
+		t.Error(8, kept)
+	}
+	// the script itself takes about 8000 edits of 32 bytes, so anything near quadratic would be far larger
+	if allocated := after.TotalAlloc - before.TotalAlloc; allocated > 4<<20 {
+		t.Error("allocated", allocated, "bytes")
+	}
+}
+
+func lcsLength(a, b []int) int {
+	table := make([][]int, len(a)+1)
+	for i := range table {
+		table[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				table[i][j] = table[i+1][j+1] + 1
+			} else {
+				table[i][j] = max(table[i+1][j], table[i][j+1])
+			}
+		}
+	}
+	return table[0][0]
+}
+
+func TestPatchInvalid(t *testing.T) {
+	script := Diff([]int{1, 2, 3}, []int{1, 3})
+	if _, err := Patch([]int{1, 2}, script); !errors.Is(err, ErrInvalidEditScript) {
+		t.Error("expected ErrInvalidEditScript, got", err)
+	}
+	if _, err := Patch([]int{1, 2, 3, 4}, script); !errors.Is(err, ErrInvalidEditScript) {
+		t.Error("expected ErrInvalidEditScript, got", err)
+	}
+}
+
+func ExampleDiff() {
+	for _, edit := range Diff([]string{"a", "b", "c"}, []string{"a", "c", "d"}) {
+		fmt.Println(edit.Kind, edit.AIndex, edit.BIndex, edit.Value)
+	}
+	// Output:
+	// equal 0 0 a
+	// delete 1 1 b
+	// equal 2 1 c
+	// insert 3 2 d
+}
+
+func ExampleDiffBy() {
+	fmt.Println(len(DiffBy([]string{"A", "b"}, []string{"a", "B"}, strings.ToLower)))
+	// Output:
+	// 2
+}
+
+func ExampleDiffWith() {
+	script := DiffWith([]float64{1.1, 2.2}, []float64{1.4, 3.3}, func(x, y float64) bool { return int(x) == int(y) })
+	fmt.Println(script)
+	// Output:
+	// [{equal 0 0 1.1} {delete 1 1 2.2} {insert 2 1 3.3}]
+}
+
+func ExamplePatch() {
+	a, b := []int{1, 2, 3}, []int{0, 1, 3, 4}
+	fmt.Println(Patch(a, Diff(a, b)))
+	// Output:
+	// [0 1 3 4] <nil>
+}
+
+func ExampleUnifiedDiff() {
+	a := []string{"a", "b", "c", "d", "e", "f", "g", "h"}
+	b := []string{"a", "B", "c", "d", "e", "f", "g", "h", "i"}
+	fmt.Print(UnifiedDiff(Diff(a, b), 1))
+	// Output:
+	// @@ -1,3 +1,3 @@
+	//  a
+	// -b
+	// +B
+	//  c
+	// @@ -8,1 +8,2 @@
+	//  h
+	// +i
+}
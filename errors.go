@@ -0,0 +1,253 @@
+package slicy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// IndexError is returned by the `...Err` functions when a callback returns an error. It records the
+// index of the element that was being processed, and unwraps to the error returned by the callback.
+type IndexError struct {
+	Index int
+	Err   error
+}
+
+func (e *IndexError) Error() string {
+	return fmt.Sprintf("index %d: %v", e.Index, e.Err)
+}
+
+func (e *IndexError) Unwrap() error {
+	return e.Err
+}
+
+// errStop is used internally to end an iteration early without reporting an error.
+var errStop = errors.New("stop")
+
+// eachErr calls `fn` for every index in `[0, n)`, wrapping any error returned in an `*IndexError`.
+// If `collect` is false it stops at the first error, otherwise it carries on and returns all the
+// errors combined with `errors.Join`. `ctx` is checked before every call, and if it is done
+// `ctx.Err()` is returned as is, or joined with the errors collected so far if there are any.
+func eachErr(ctx context.Context, n int, collect bool, fn func(i int) error) error {
+	var errs []error
+	for i := 0; i < n; i++ {
+		if err := ctx.Err(); err != nil {
+			if len(errs) == 0 {
+				return err
+			}
+			return errors.Join(append(errs, err)...)
+		}
+		if err := fn(i); err != nil {
+			err = &IndexError{Index: i, Err: err}
+			if !collect {
+				return err
+			}
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// EachErr invokes the given `iteratee` for every element in the slice, from left to right, stopping
+// at the first error. The error is returned as an `*IndexError`.
+func EachErr[S ~[]T, T any](slice S, iteratee func(value T, index int, slice S) error) error {
+	return eachErr(context.Background(), len(slice), false, func(i int) error { return iteratee(slice[i], i, slice) })
+}
+
+// EachErrAll invokes the given `iteratee` for every element in the slice, from left to right, and
+// returns all the errors encountered as `*IndexError` values combined with `errors.Join`.
+func EachErrAll[S ~[]T, T any](slice S, iteratee func(value T, index int, slice S) error) error {
+	return eachErr(context.Background(), len(slice), true, func(i int) error { return iteratee(slice[i], i, slice) })
+}
+
+// EveryErr returns true if the given `predicate` returns true for every element of the given slice.
+// It stops at the first error, returning false and the error as an `*IndexError`.
+func EveryErr[S ~[]T, T any](slice S, predicate func(value T, index int, slice S) (bool, error)) (bool, error) {
+	found, err := SomeErr(slice, negateErr(predicate))
+	return !found && err == nil, err
+}
+
+// SomeErr returns true if the given `predicate` returns true for any element of the given slice.
+// It stops at the first error, returning false and the error as an `*IndexError`.
+func SomeErr[S ~[]T, T any](slice S, predicate func(value T, index int, slice S) (bool, error)) (bool, error) {
+	_, index, err := findErr(context.Background(), slice, predicate)
+	return index != -1, err
+}
+
+func findErr[S ~[]T, T any](ctx context.Context, slice S, predicate func(value T, index int, slice S) (bool, error)) (result T, index int, err error) {
+	index = -1
+	err = eachErr(ctx, len(slice), false, func(i int) error {
+		ok, err := predicate(slice[i], i, slice)
+		if err == nil && ok {
+			result, index = slice[i], i
+			return errStop
+		}
+		return err
+	})
+	if errors.Is(err, errStop) {
+		err = nil
+	}
+	return
+}
+
+// FindErr returns the first element that `predicate` returns true for, stopping at the first error.
+// The error is returned as an `*IndexError`, along with the zero value.
+func FindErr[S ~[]T, T any](slice S, predicate func(value T, index int, slice S) (bool, error)) (result T, err error) {
+	result, _, err = findErr(context.Background(), slice, predicate)
+	return
+}
+
+func filterErr[S ~[]T, T any](ctx context.Context, slice S, collect bool, predicate func(value T, index int, slice S) (bool, error)) (S, error) {
+	output := make(S, 0)
+	err := eachErr(ctx, len(slice), collect, func(i int) error {
+		ok, err := predicate(slice[i], i, slice)
+		if err == nil && ok {
+			output = append(output, slice[i])
+		}
+		return err
+	})
+	return output, err
+}
+
+// FilterErr returns a slice of all elements that the `predicate` returns true for, stopping at the
+// first error. The error is returned as an `*IndexError`, along with the elements kept so far.
+func FilterErr[S ~[]T, T any](slice S, predicate func(value T, index int, slice S) (bool, error)) (S, error) {
+	return filterErr(context.Background(), slice, false, predicate)
+}
+
+// FilterErrAll returns a slice of all elements that the `predicate` returns true for, skipping elements
+// for which `predicate` returns an error. All the errors are returned as `*IndexError` values combined
+// with `errors.Join`.
+func FilterErrAll[S ~[]T, T any](slice S, predicate func(value T, index int, slice S) (bool, error)) (S, error) {
+	return filterErr(context.Background(), slice, true, predicate)
+}
+
+func flatMapErr[S ~[]T, T any, U any](ctx context.Context, slice S, collect bool, iteratee func(value T, index int, slice S) ([]U, error)) ([]U, error) {
+	output := make([]U, 0)
+	err := eachErr(ctx, len(slice), collect, func(i int) error {
+		mapped, err := iteratee(slice[i], i, slice)
+		if err == nil {
+			output = append(output, mapped...)
+		}
+		return err
+	})
+	return output, err
+}
+
+// FlatMapErr creates a flattened slice of values by running each element in `slice` through `iteratee`,
+// stopping at the first error. The error is returned as an `*IndexError`, along with the values
+// flattened so far.
+func FlatMapErr[S ~[]T, T any, U any](slice S, iteratee func(value T, index int, slice S) ([]U, error)) ([]U, error) {
+	return flatMapErr(context.Background(), slice, false, iteratee)
+}
+
+// FlatMapErrAll creates a flattened slice of values by running each element in `slice` through `iteratee`,
+// skipping elements for which `iteratee` returns an error. All the errors are returned as `*IndexError`
+// values combined with `errors.Join`.
+func FlatMapErrAll[S ~[]T, T any, U any](slice S, iteratee func(value T, index int, slice S) ([]U, error)) ([]U, error) {
+	return flatMapErr(context.Background(), slice, true, iteratee)
+}
+
+// GroupByErr is like `GroupBy`, but stops at the first error returned by `iteratee`. The error is returned
+// as an `*IndexError`, along with the groups built so far.
+func GroupByErr[S ~[]T, T any, U comparable](slice S, iteratee func(T) (U, error)) (map[U]S, error) {
+	return groupByErr(context.Background(), slice, iteratee)
+}
+
+func groupByErr[S ~[]T, T any, U comparable](ctx context.Context, slice S, iteratee func(T) (U, error)) (map[U]S, error) {
+	output := make(map[U]S)
+	err := eachErr(ctx, len(slice), false, func(i int) error {
+		key, err := iteratee(slice[i])
+		if err == nil {
+			output[key] = append(output[key], slice[i])
+		}
+		return err
+	})
+	return output, err
+}
+
+// KeyByErr is like `KeyBy`, but stops at the first error returned by `iteratee`. The error is returned
+// as an `*IndexError`, along with the keys built so far.
+func KeyByErr[S ~[]T, T any, U comparable](slice S, iteratee func(T) (U, error)) (map[U]T, error) {
+	return keyByErr(context.Background(), slice, iteratee)
+}
+
+func keyByErr[S ~[]T, T any, U comparable](ctx context.Context, slice S, iteratee func(T) (U, error)) (map[U]T, error) {
+	output := make(map[U]T)
+	err := eachErr(ctx, len(slice), false, func(i int) error {
+		key, err := iteratee(slice[i])
+		if err == nil {
+			output[key] = slice[i]
+		}
+		return err
+	})
+	return output, err
+}
+
+// MapErr creates a slice of values by running each element in `slice` through `iteratee`, stopping at
+// the first error. The error is returned as an `*IndexError`, along with the values mapped before it.
+func MapErr[S ~[]T, T any, U any](slice S, iteratee func(T) (U, error)) ([]U, error) {
+	return mapErr(context.Background(), slice, iteratee)
+}
+
+func mapErr[S ~[]T, T any, U any](ctx context.Context, slice S, iteratee func(T) (U, error)) ([]U, error) {
+	output := make([]U, len(slice))
+	mapped := 0
+	err := eachErr(ctx, len(slice), false, func(i int) (err error) {
+		output[i], err = iteratee(slice[i])
+		if err == nil {
+			mapped++
+		}
+		return
+	})
+	return output[:mapped], err
+}
+
+// MapErrAll creates a slice of values by running each element in `slice` through `iteratee`, carrying on
+// past errors. The output always has the same length as `slice`, holding whatever `iteratee` returned for
+// the elements that failed. All the errors are returned as `*IndexError` values combined with `errors.Join`.
+func MapErrAll[S ~[]T, T any, U any](slice S, iteratee func(T) (U, error)) ([]U, error) {
+	output := make([]U, len(slice))
+	err := eachErr(context.Background(), len(slice), true, func(i int) (err error) {
+		output[i], err = iteratee(slice[i])
+		return
+	})
+	return output, err
+}
+
+// ReduceErr is like `Reduce`, but stops at the first error returned by `iteratee`. The error is returned
+// as an `*IndexError`, along with the accumulated value before the failing element.
+func ReduceErr[S ~[]T, T any, U any](slice S, iteratee func(acc U, value T, index int, slice S) (U, error), accumulator U) (U, error) {
+	return reduceErr(context.Background(), slice, iteratee, accumulator)
+}
+
+func reduceErr[S ~[]T, T any, U any](ctx context.Context, slice S, iteratee func(acc U, value T, index int, slice S) (U, error), accumulator U) (U, error) {
+	err := eachErr(ctx, len(slice), false, func(i int) error {
+		next, err := iteratee(accumulator, slice[i], i, slice)
+		if err == nil {
+			accumulator = next
+		}
+		return err
+	})
+	return accumulator, err
+}
+
+// RejectErr returns a slice of all elements that the `predicate` returns false for, stopping at the
+// first error. The error is returned as an `*IndexError`, along with the elements kept so far.
+func RejectErr[S ~[]T, T any](slice S, predicate func(value T, index int, slice S) (bool, error)) (S, error) {
+	return filterErr(context.Background(), slice, false, negateErr(predicate))
+}
+
+// RejectErrAll returns a slice of all elements that the `predicate` returns false for, skipping elements
+// for which `predicate` returns an error. All the errors are returned as `*IndexError` values combined
+// with `errors.Join`.
+func RejectErrAll[S ~[]T, T any](slice S, predicate func(value T, index int, slice S) (bool, error)) (S, error) {
+	return filterErr(context.Background(), slice, true, negateErr(predicate))
+}
+
+func negateErr[S ~[]T, T any](predicate func(value T, index int, slice S) (bool, error)) func(T, int, S) (bool, error) {
+	return func(value T, index int, slice S) (bool, error) {
+		ok, err := predicate(value, index, slice)
+		return !ok, err
+	}
+}
@@ -0,0 +1,186 @@
+package slicy
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestMapErr(t *testing.T) {
+	tests := []struct {
+		name  string
+		i     []string
+		o     []int
+		index int
+	}{
+		{"empty", []string{}, []int{}, -1},
+		{"ok", []string{"1", "2"}, []int{1, 2}, -1},
+		{"first", []string{"x", "2"}, []int{}, 0},
+		{"middle", []string{"1", "x", "3", "y"}, []int{1}, 1},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			op, err := MapErr(test.i, strconv.Atoi)
+			if !reflect.DeepEqual(op, test.o) {
+				t.Error(test.o, op)
+			}
+			var indexErr *IndexError
+			if test.index == -1 && err != nil {
+				t.Error("unexpected error", err)
+			}
+			if test.index != -1 && (!errors.As(err, &indexErr) || indexErr.Index != test.index) {
+				t.Error("expected error at", test.index, err)
+			}
+		})
+	}
+}
+
+func ExampleIndexError() {
+	_, err := MapErr([]string{"1", "x"}, strconv.Atoi)
+	var indexErr *IndexError
+	fmt.Println(errors.As(err, &indexErr), indexErr.Index)
+	fmt.Println(errors.Is(err, strconv.ErrSyntax))
+	// Output:
+	// true 1
+	// true
+}
+
+func ExampleEachErr() {
+	err := EachErr([]int{1, 2, 3}, func(v int, _ int, _ []int) error {
+		fmt.Println(v)
+		if v == 2 {
+			return errors.New("two")
+		}
+		return nil
+	})
+	fmt.Println(err)
+	// Output:
+	// 1
+	// 2
+	// index 1: two
+}
+
+func ExampleEachErrAll() {
+	err := EachErrAll([]int{1, 2, 3}, func(v int, _ int, _ []int) error {
+		if v != 2 {
+			return fmt.Errorf("not two: %d", v)
+		}
+		return nil
+	})
+	fmt.Println(err)
+	// Output:
+	// index 0: not two: 1
+	// index 2: not two: 3
+}
+
+func ExampleEveryErr() {
+	isEven := func(s string, _ int, _ []string) (bool, error) {
+		n, err := strconv.Atoi(s)
+		return n%2 == 0, err
+	}
+	fmt.Println(EveryErr([]string{"2", "4"}, isEven))
+	fmt.Println(EveryErr([]string{"2", "3", "x"}, isEven))
+	fmt.Println(EveryErr([]string{"2", "x", "3"}, isEven))
+	// Output:
+	// true <nil>
+	// false <nil>
+	// false index 1: strconv.Atoi: parsing "x": invalid syntax
+}
+
+func ExampleSomeErr() {
+	isEven := func(s string, _ int, _ []string) (bool, error) {
+		n, err := strconv.Atoi(s)
+		return n%2 == 0, err
+	}
+	fmt.Println(SomeErr([]string{"1", "4", "x"}, isEven))
+	fmt.Println(SomeErr([]string{"1", "x", "4"}, isEven))
+	// Output:
+	// true <nil>
+	// false index 1: strconv.Atoi: parsing "x": invalid syntax
+}
+
+func ExampleFindErr() {
+	isBig := func(s string, _ int, _ []string) (bool, error) {
+		n, err := strconv.Atoi(s)
+		return n > 10, err
+	}
+	fmt.Println(FindErr([]string{"1", "42", "x"}, isBig))
+	fmt.Println(FindErr([]string{"1", "x", "42"}, isBig))
+	// Output:
+	// 42 <nil>
+	//  index 1: strconv.Atoi: parsing "x": invalid syntax
+}
+
+func ExampleFilterErr() {
+	isEven := func(s string, _ int, _ []string) (bool, error) {
+		n, err := strconv.Atoi(s)
+		return n%2 == 0, err
+	}
+	fmt.Println(FilterErr([]string{"1", "2", "x", "4"}, isEven))
+	fmt.Println(FilterErrAll([]string{"1", "2", "x", "4"}, isEven))
+	// Output:
+	// [2] index 2: strconv.Atoi: parsing "x": invalid syntax
+	// [2 4] index 2: strconv.Atoi: parsing "x": invalid syntax
+}
+
+func ExampleFlatMapErr() {
+	fmt.Println(FlatMapErr([]string{"1", "2"}, func(s string, _ int, _ []string) ([]int, error) {
+		n, err := strconv.Atoi(s)
+		return []int{n, n}, err
+	}))
+	// Output:
+	// [1 1 2 2] <nil>
+}
+
+func ExampleGroupByErr() {
+	fmt.Println(GroupByErr([]string{"1", "2", "11"}, func(s string) (int, error) { return len(s), nil }))
+	// Output:
+	// map[1:[1 2] 2:[11]] <nil>
+}
+
+func ExampleKeyByErr() {
+	fmt.Println(KeyByErr([]string{"1", "x"}, strconv.Atoi))
+	// Output:
+	// map[1:1] index 1: strconv.Atoi: parsing "x": invalid syntax
+}
+
+func ExampleMapErr() {
+	fmt.Println(MapErr([]string{"1", "2", "3"}, strconv.Atoi))
+	fmt.Println(MapErr([]string{"1", "x", "3"}, strconv.Atoi))
+	// Output:
+	// [1 2 3] <nil>
+	// [1] index 1: strconv.Atoi: parsing "x": invalid syntax
+}
+
+func ExampleMapErrAll() {
+	fmt.Println(MapErrAll([]string{"1", "x", "3", "y"}, strconv.Atoi))
+	// Output:
+	// [1 0 3 0] index 1: strconv.Atoi: parsing "x": invalid syntax
+	// index 3: strconv.Atoi: parsing "y": invalid syntax
+}
+
+func ExampleReduceErr() {
+	sum := func(acc int, s string, _ int, _ []string) (int, error) {
+		n, err := strconv.Atoi(s)
+		return acc + n, err
+	}
+	fmt.Println(ReduceErr([]string{"1", "2", "3"}, sum, 0))
+	fmt.Println(ReduceErr([]string{"1", "2", "x"}, sum, 0))
+	// Output:
+	// 6 <nil>
+	// 3 index 2: strconv.Atoi: parsing "x": invalid syntax
+}
+
+func ExampleRejectErr() {
+	isEven := func(s string, _ int, _ []string) (bool, error) {
+		n, err := strconv.Atoi(s)
+		return n%2 == 0, err
+	}
+	fmt.Println(RejectErr([]string{"1", "2", "3"}, isEven))
+	fmt.Println(RejectErrAll([]string{"1", "x", "3"}, isEven))
+	// Output:
+	// [1 3] <nil>
+	// [1 3] index 1: strconv.Atoi: parsing "x": invalid syntax
+}
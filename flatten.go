@@ -0,0 +1,50 @@
+package slicy
+
+import (
+	"math"
+	"reflect"
+)
+
+// Flatten joins the slices in `slices` into a single new slice, in order. The output is allocated at its
+// final length up front.
+func Flatten[S ~[]T, T any](slices []S) S {
+	total := 0
+	for _, slice := range slices {
+		total += len(slice)
+	}
+	output := make(S, 0, total)
+	for _, slice := range slices {
+		output = append(output, slice...)
+	}
+	return output
+}
+
+// FlattenDepth flattens nested slices in `slice`, such as the `[]any` values produced by decoding JSON, up to
+// `depth` levels deep. Any element that is a slice of any type is replaced by its elements, which are
+// themselves flattened if `depth` allows. Everything else is a leaf and is kept as it is, including
+// strings, arrays, maps and nil. A `depth` less than 1 returns a copy of `slice`.
+func FlattenDepth(slice []any, depth int) []any {
+	output := make([]any, 0, len(slice))
+	for _, item := range slice {
+		output = flattenInto(output, item, depth)
+	}
+	return output
+}
+
+// FlattenDeep is like `FlattenDepth` without a depth limit: it flattens nested slices in `slice` until only
+// leaves remain. Slices that contain themselves are not supported.
+func FlattenDeep(slice []any) []any {
+	return FlattenDepth(slice, math.MaxInt)
+}
+
+// flattenInto appends `item` to `output`, or its elements if it is a slice and `depth` is at least 1.
+func flattenInto(output []any, item any, depth int) []any {
+	value := reflect.ValueOf(item)
+	if depth < 1 || value.Kind() != reflect.Slice {
+		return append(output, item)
+	}
+	for i := 0; i < value.Len(); i++ {
+		output = flattenInto(output, value.Index(i).Interface(), depth-1)
+	}
+	return output
+}
@@ -0,0 +1,32 @@
+package slicy
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+func ExampleFlatten() {
+	fmt.Println(Flatten([][]int{{1, 2}, {}, {3}, nil, {4, 5}}))
+	fmt.Println(Flatten([][]int{}))
+	// Output:
+	// [1 2 3 4 5]
+	// []
+}
+
+func ExampleFlattenDepth() {
+	var nested []any
+	_ = json.Unmarshal([]byte(`[1, [2, [3, [4]], "five"], {"six": 6}, null]`), &nested)
+	fmt.Println(FlattenDepth(nested, 0))
+	fmt.Println(FlattenDepth(nested, 1))
+	fmt.Println(FlattenDepth(nested, 2))
+	// Output:
+	// [1 [2 [3 [4]] five] map[six:6] <nil>]
+	// [1 2 [3 [4]] five map[six:6] <nil>]
+	// [1 2 3 [4] five map[six:6] <nil>]
+}
+
+func ExampleFlattenDeep() {
+	fmt.Println(FlattenDeep([]any{1, []int{2, 3}, [][]string{{"a"}, {"b", "c"}}, [2]int{4, 5}, "de"}))
+	// Output:
+	// [1 2 3 a b c [4 5] de]
+}
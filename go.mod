@@ -1,5 +1,5 @@
 module github.com/sudhirj/slicy
 
-go 1.18
+go 1.23
 
 require golang.org/x/exp v0.0.0-20220328175248-053ad81199eb
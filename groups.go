@@ -0,0 +1,55 @@
+package slicy
+
+import (
+	"golang.org/x/exp/constraints"
+	"golang.org/x/exp/slices"
+)
+
+// Group is a key along with the elements that produced it, as returned by `GroupByOrdered`. `Items` has
+// the slice type of the slice that was grouped.
+type Group[K comparable, S any] struct {
+	Key   K
+	Items S
+}
+
+// GroupByOrdered is like `GroupBy`, but returns the groups as a slice with keys in the order they are
+// first seen in `slice`, so that iterating over the result is deterministic. The elements of each
+// group are in the order they occur in `slice`.
+func GroupByOrdered[S ~[]T, T any, U comparable](slice S, iteratee func(T) U) []Group[U, S] {
+	groups := AggregateBy(slice, iteratee, S(nil), func(items S, item T) S { return append(items, item) })
+	return Map(groups, func(kv KeyValue[U, S]) Group[U, S] { return Group[U, S]{kv.Key, kv.Value} })
+}
+
+// CountByOrdered is like `CountBy`, but returns the counts as a slice with keys in the order they are
+// first seen in `slice`.
+func CountByOrdered[S ~[]T, T any, U comparable](slice S, iteratee func(T) U) []KeyValue[U, int] {
+	return AggregateBy(slice, iteratee, 0, func(count int, _ T) int { return count + 1 })
+}
+
+// KeyByOrdered is like `KeyBy`, but returns the keyed elements as a slice with keys in the order they
+// are first seen in `slice`. The value of each key is still the last element responsible for generating it.
+func KeyByOrdered[S ~[]T, T any, U comparable](slice S, iteratee func(T) U) []KeyValue[U, T] {
+	var zero T
+	return AggregateBy(slice, iteratee, zero, func(_ T, item T) T { return item })
+}
+
+// SortGroupsByKey returns a copy of `groups` sorted by ascending key.
+func SortGroupsByKey[K constraints.Ordered, S any](groups []Group[K, S]) []Group[K, S] {
+	output := slices.Clone(groups)
+	slices.SortStableFunc(output, func(a, b Group[K, S]) bool { return a.Key < b.Key })
+	return output
+}
+
+// SortGroupsBySize returns a copy of `groups` sorted from the most to the fewest items. Groups of the
+// same size keep their relative order.
+func SortGroupsBySize[K comparable, S ~[]T, T any](groups []Group[K, S]) []Group[K, S] {
+	output := slices.Clone(groups)
+	slices.SortStableFunc(output, func(a, b Group[K, S]) bool { return len(a.Items) > len(b.Items) })
+	return output
+}
+
+// TopGroups returns the `n` groups with the most items, largest first. Groups of the same size keep
+// their relative order, so with `GroupByOrdered` ties go to the key seen first.
+func TopGroups[K comparable, S ~[]T, T any](groups []Group[K, S], n int) []Group[K, S] {
+	return Take(SortGroupsBySize(groups), max(n, 0))
+}
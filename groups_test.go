@@ -0,0 +1,68 @@
+package slicy
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+var groupWords = []string{"banana", "apple", "cherry", "avocado", "blueberry", "apricot"}
+
+func firstLetter(s string) string { return s[:1] }
+
+func ExampleGroupByOrdered() {
+	fmt.Println(GroupByOrdered(groupWords, firstLetter))
+	fmt.Println(GroupByOrdered([]string{}, firstLetter))
+	// Output:
+	// [{b [banana blueberry]} {a [apple avocado apricot]} {c [cherry]}]
+	// []
+}
+
+func ExampleCountByOrdered() {
+	fmt.Println(CountByOrdered(groupWords, firstLetter))
+	// Output:
+	// [{b 2} {a 3} {c 1}]
+}
+
+func ExampleKeyByOrdered() {
+	fmt.Println(KeyByOrdered(groupWords, firstLetter))
+	// Output:
+	// [{b blueberry} {a apricot} {c cherry}]
+}
+
+func ExampleSortGroupsByKey() {
+	fmt.Println(SortGroupsByKey(GroupByOrdered(groupWords, firstLetter)))
+	// Output:
+	// [{a [apple avocado apricot]} {b [banana blueberry]} {c [cherry]}]
+}
+
+func ExampleSortGroupsBySize() {
+	fmt.Println(SortGroupsBySize(GroupByOrdered(groupWords, strings.ToUpper)))
+	fmt.Println(SortGroupsBySize(GroupByOrdered(groupWords, firstLetter)))
+	// Output:
+	// [{BANANA [banana]} {APPLE [apple]} {CHERRY [cherry]} {AVOCADO [avocado]} {BLUEBERRY [blueberry]} {APRICOT [apricot]}]
+	// [{a [apple avocado apricot]} {b [banana blueberry]} {c [cherry]}]
+}
+
+func ExampleTopGroups() {
+	groups := GroupByOrdered(groupWords, firstLetter)
+	fmt.Println(TopGroups(groups, 2))
+	fmt.Println(TopGroups(groups, 5))
+	fmt.Println(TopGroups(groups, -1))
+	// Output:
+	// [{a [apple avocado apricot]} {b [banana blueberry]}]
+	// [{a [apple avocado apricot]} {b [banana blueberry]} {c [cherry]}]
+	// []
+}
+
+type wordList []string
+
+func (w wordList) String() string { return strings.Join(w, "+") }
+
+func TestGroupByOrderedKeepsSliceType(t *testing.T) {
+	groups := TopGroups(GroupByOrdered(wordList(groupWords), firstLetter), 1)
+	var items wordList = groups[0].Items
+	if op := items.String(); op != "apple+avocado+apricot" {
+		t.Error("apple+avocado+apricot", op)
+	}
+}
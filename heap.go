@@ -0,0 +1,220 @@
+package slicy
+
+import (
+	"golang.org/x/exp/constraints"
+)
+
+// Heap is a binary heap that keeps the element for which `less` returns true against all others at the
+// top, so a heap built with `<` pops its elements from smallest to largest. Create one with `NewHeap`
+// or `NewOrderedHeap`. Pushing and popping take O(log n) time.
+type Heap[T any] struct {
+	items []T
+	less  func(a, b T) bool
+}
+
+// NewHeap creates a heap ordered by `less`, holding a copy of the given `items`. Building the heap
+// from existing items takes O(n) time.
+func NewHeap[T any](less func(a, b T) bool, items ...T) *Heap[T] {
+	h := &Heap[T]{items: append([]T(nil), items...), less: less}
+	for i := len(h.items)/2 - 1; i >= 0; i-- {
+		h.down(i)
+	}
+	return h
+}
+
+// NewOrderedHeap creates a min-heap holding a copy of the given `items`, ordered with `<`.
+func NewOrderedHeap[T constraints.Ordered](items ...T) *Heap[T] {
+	return NewHeap(func(a, b T) bool { return a < b }, items...)
+}
+
+// Len returns the number of elements in the heap.
+func (h *Heap[T]) Len() int {
+	return len(h.items)
+}
+
+// Push adds `value` to the heap.
+func (h *Heap[T]) Push(value T) {
+	h.items = append(h.items, value)
+	h.up(len(h.items) - 1)
+}
+
+// Peek returns the element at the top of the heap without removing it. For an empty heap it returns
+// the zero value and false.
+func (h *Heap[T]) Peek() (result T, ok bool) {
+	if len(h.items) == 0 {
+		return
+	}
+	return h.items[0], true
+}
+
+// Pop removes and returns the element at the top of the heap. For an empty heap it returns the zero
+// value and false.
+func (h *Heap[T]) Pop() (T, bool) {
+	return h.Remove(0)
+}
+
+// Remove removes and returns the element at index `i` of `Values`. For an out of range `i` it returns
+// the zero value and false.
+func (h *Heap[T]) Remove(i int) (result T, ok bool) {
+	if i < 0 || i >= len(h.items) {
+		return
+	}
+	last := len(h.items) - 1
+	result = h.items[i]
+	h.items[i] = h.items[last]
+	var zero T
+	h.items[last] = zero
+	h.items = h.items[:last]
+	if i < last {
+		h.Fix(i)
+	}
+	return result, true
+}
+
+// Fix restores the heap order after the element at index `i` of `Values` has changed. It is cheaper
+// than removing the element and pushing it again. Out of range indexes are ignored.
+func (h *Heap[T]) Fix(i int) {
+	if i < 0 || i >= len(h.items) {
+		return
+	}
+	if !h.down(i) {
+		h.up(i)
+	}
+}
+
+// Values returns the elements of the heap in their internal order, which is not sorted except that the
+// top of the heap comes first. The returned slice is the heap's own storage: an element may be changed
+// in place as long as `Fix` is called with its index afterwards, but the slice must not be appended to.
+func (h *Heap[T]) Values() []T {
+	return h.items
+}
+
+func (h *Heap[T]) up(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !h.less(h.items[i], h.items[parent]) {
+			return
+		}
+		h.items[i], h.items[parent] = h.items[parent], h.items[i]
+		i = parent
+	}
+}
+
+// down moves the element at index `i` towards the bottom of the heap, reporting whether it moved.
+func (h *Heap[T]) down(i int) bool {
+	start := i
+	for {
+		child := 2*i + 1
+		if child >= len(h.items) {
+			break
+		}
+		if right := child + 1; right < len(h.items) && h.less(h.items[right], h.items[child]) {
+			child = right
+		}
+		if !h.less(h.items[child], h.items[i]) {
+			break
+		}
+		h.items[i], h.items[child] = h.items[child], h.items[i]
+		i = child
+	}
+	return i > start
+}
+
+// TopN returns the `n` largest elements of `slice`, from largest to smallest. It runs in O(len(slice) log n)
+// time, which is faster than sorting the whole slice when `n` is small.
+func TopN[S ~[]T, T constraints.Ordered](slice S, n int) S {
+	return NLargestBy(slice, n, identity[T])
+}
+
+// BottomN returns the `n` smallest elements of `slice`, from smallest to largest.
+func BottomN[S ~[]T, T constraints.Ordered](slice S, n int) S {
+	return NSmallestBy(slice, n, identity[T])
+}
+
+// NLargestBy returns the `n` elements of `slice` with the largest results from `iteratee`, from largest
+// to smallest. Elements with equal results keep the order they have in `slice`, and `iteratee` is called
+// once per element.
+func NLargestBy[S ~[]T, T any, U constraints.Ordered](slice S, n int, iteratee func(T) U) S {
+	return selectN(slice, n, iteratee, func(a, b U) bool { return a > b })
+}
+
+// NSmallestBy returns the `n` elements of `slice` with the smallest results from `iteratee`, from smallest
+// to largest. Elements with equal results keep the order they have in `slice`.
+func NSmallestBy[S ~[]T, T any, U constraints.Ordered](slice S, n int, iteratee func(T) U) S {
+	return selectN(slice, n, iteratee, func(a, b U) bool { return a < b })
+}
+
+// selectN keeps the `n` best elements of `slice` by `before` in a heap with the worst of them at the top,
+// so that each remaining element only has to be compared with that one.
+func selectN[S ~[]T, T any, U constraints.Ordered](slice S, n int, iteratee func(T) U, before func(a, b U) bool) S {
+	type entry struct {
+		item  T
+		key   U
+		index int
+	}
+	// ahead reports whether `a` should be returned before `b`
+	ahead := func(a, b entry) bool {
+		if before(a.key, b.key) || before(b.key, a.key) {
+			return before(a.key, b.key)
+		}
+		return a.index < b.index
+	}
+	n = min(max(n, 0), len(slice))
+	kept := NewHeap(func(a, b entry) bool { return ahead(b, a) })
+	for i, item := range slice {
+		e := entry{item, iteratee(item), i}
+		if kept.Len() < n {
+			kept.Push(e)
+		} else if worst, ok := kept.Peek(); ok && ahead(e, worst) {
+			kept.items[0] = e
+			kept.down(0)
+		}
+	}
+	output := make(S, kept.Len())
+	for i := len(output) - 1; i >= 0; i-- {
+		e, _ := kept.Pop()
+		output[i] = e.item
+	}
+	return output
+}
+
+// MergeSorted merges `slices` that are each sorted in ascending order into a single sorted slice, using
+// a heap to pick the next element in O(log k) time for k slices. Equal elements are taken from earlier
+// slices first.
+func MergeSorted[S ~[]T, T constraints.Ordered](slices ...S) S {
+	return MergeSortedBy(identity[T], slices...)
+}
+
+// MergeSortedBy is like `MergeSorted`, but for `slices` that are each sorted in ascending order of the
+// result of passing their elements through `iteratee`.
+func MergeSortedBy[S ~[]T, T any, U constraints.Ordered](iteratee func(T) U, slices ...S) S {
+	type cursor struct {
+		key   U
+		slice int
+		next  int
+	}
+	total := 0
+	heads := make([]cursor, 0, len(slices))
+	for i, slice := range slices {
+		total += len(slice)
+		if len(slice) > 0 {
+			heads = append(heads, cursor{iteratee(slice[0]), i, 1})
+		}
+	}
+	pending := NewHeap(func(a, b cursor) bool {
+		return a.key < b.key || (a.key == b.key && a.slice < b.slice)
+	}, heads...)
+	output := make(S, 0, total)
+	for pending.Len() > 0 {
+		c := pending.items[0]
+		slice := slices[c.slice]
+		output = append(output, slice[c.next-1])
+		if c.next < len(slice) {
+			pending.items[0] = cursor{iteratee(slice[c.next]), c.slice, c.next + 1}
+			pending.down(0)
+		} else {
+			pending.Pop()
+		}
+	}
+	return output
+}
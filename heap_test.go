@@ -0,0 +1,139 @@
+package slicy
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"reflect"
+	"testing"
+
+	"golang.org/x/exp/slices"
+)
+
+func ExampleNewHeap() {
+	h := NewHeap(func(a, b string) bool { return len(a) < len(b) }, "ccc", "a", "bbbb")
+	h.Push("dd")
+	popped := make([]string, 0)
+	for h.Len() > 0 {
+		v, _ := h.Pop()
+		popped = append(popped, v)
+	}
+	fmt.Println(popped)
+	fmt.Println(h.Pop())
+	// Output:
+	// [a dd ccc bbbb]
+	//  false
+}
+
+func ExampleNewOrderedHeap() {
+	h := NewOrderedHeap(5, 3, 8)
+	fmt.Println(h.Peek())
+	fmt.Println(h.Len())
+	// Output:
+	// 3 true
+	// 3
+}
+
+func ExampleHeap_Fix() {
+	h := NewOrderedHeap(1, 4, 7)
+	h.Values()[0] = 10
+	h.Fix(0)
+	fmt.Println(h.Peek())
+	// Output:
+	// 4 true
+}
+
+func ExampleHeap_Remove() {
+	h := NewOrderedHeap(1, 4, 7)
+	i := slices.Index(h.Values(), 4)
+	fmt.Println(h.Remove(i))
+	fmt.Println(h.Remove(5))
+	fmt.Println(h.Pop())
+	fmt.Println(h.Pop())
+	// Output:
+	// 4 true
+	// 0 false
+	// 1 true
+	// 7 true
+}
+
+func ExampleTopN() {
+	fmt.Println(TopN([]int{5, 1, 9, 3, 7}, 3))
+	fmt.Println(TopN([]int{5, 1}, 3))
+	fmt.Println(TopN([]int{5, 1}, 0))
+	// Output:
+	// [9 7 5]
+	// [5 1]
+	// []
+}
+
+func ExampleBottomN() {
+	fmt.Println(BottomN([]int{5, 1, 9, 3, 7}, 3))
+	// Output:
+	// [1 3 5]
+}
+
+func ExampleNLargestBy() {
+	fmt.Println(NLargestBy([]string{"b", "ccc", "dd", "eee", "a"}, 3, func(s string) int { return len(s) }))
+	// Output:
+	// [ccc eee dd]
+}
+
+func ExampleNSmallestBy() {
+	fmt.Println(NSmallestBy([]string{"b", "ccc", "dd", "eee", "a"}, 3, func(s string) int { return len(s) }))
+	// Output:
+	// [b a dd]
+}
+
+func ExampleMergeSorted() {
+	fmt.Println(MergeSorted([]int{1, 4, 7}, []int{2, 5, 8}, nil, []int{3, 6, 9, 10}))
+	fmt.Println(MergeSorted[[]int]())
+	// Output:
+	// [1 2 3 4 5 6 7 8 9 10]
+	// []
+}
+
+func ExampleMergeSortedBy() {
+	a := []string{"a", "ccc"}
+	b := []string{"b", "dd", "eeee"}
+	fmt.Println(MergeSortedBy(func(s string) int { return len(s) }, a, b))
+	// Output:
+	// [a b dd ccc eeee]
+}
+
+func TestHeapMatchesSort(t *testing.T) {
+	rng := rand.New(rand.NewPCG(1, 2))
+	for size := 0; size < 50; size++ {
+		values := make([]int, size)
+		for i := range values {
+			values[i] = rng.IntN(20)
+		}
+		h := NewOrderedHeap(values[:size/2]...)
+		for _, v := range values[size/2:] {
+			h.Push(v)
+		}
+		if size > 2 {
+			h.Values()[size/3] = rng.IntN(20)
+			h.Fix(size / 3)
+			h.Remove(size / 4)
+		}
+		remaining := append([]int{}, h.Values()...)
+		slices.Sort(remaining)
+		popped := make([]int, 0)
+		for h.Len() > 0 {
+			v, _ := h.Pop()
+			popped = append(popped, v)
+		}
+		if !reflect.DeepEqual(popped, remaining) {
+			t.Error(remaining, popped)
+		}
+
+		sorted := slices.Clone(values)
+		slices.Sort(sorted)
+		if op, o := BottomN(values, size/2), sorted[:size/2]; !reflect.DeepEqual(op, o) {
+			t.Error("BottomN", values, o, op)
+		}
+		if op, o := TopN(values, size/2), Reverse(sorted)[:size/2]; !reflect.DeepEqual(op, o) {
+			t.Error("TopN", values, o, op)
+		}
+	}
+}
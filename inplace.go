@@ -0,0 +1,88 @@
+package slicy
+
+// The functions in this file modify the slice passed to them instead of allocating a new one.
+// Each returns the shortened slice, which shares the backing array of the input. The elements
+// between the new and old lengths are set to the zero value, so that anything they referenced
+// can be garbage collected.
+
+// CompactInPlace removes the zero values from `slice`, keeping the order of the rest. The input
+// slice is modified and should not be used afterwards; use the returned slice instead.
+func CompactInPlace[S ~[]T, T comparable](slice S) S {
+	var zero T
+	return RemoveInPlace(slice, func(v T, _ int, _ S) bool { return v == zero })
+}
+
+// Compact returns a new slice with all the zero values in `slice` removed.
+func Compact[S ~[]T, T comparable](slice S) S {
+	var zero T
+	return Remove(slice, func(v T, _ int, _ S) bool { return v == zero })
+}
+
+// FilterInPlace keeps only the elements of `slice` that `predicate` returns true for, in order. The
+// input slice is modified and should not be used afterwards; use the returned slice instead. The
+// `predicate` receives the index each element had in the original slice, and while it runs only
+// the elements from that index onwards are guaranteed to be unchanged.
+func FilterInPlace[S ~[]T, T any](slice S, predicate func(value T, index int, slice S) bool) S {
+	kept := 0
+	for i := range slice {
+		if predicate(slice[i], i, slice) {
+			slice[kept] = slice[i]
+			kept++
+		}
+	}
+	clear(slice[kept:])
+	return slice[:kept]
+}
+
+// RejectInPlace removes the elements of `slice` that `predicate` returns true for, keeping the order of
+// the rest. It works like `FilterInPlace` with the `predicate` inverted.
+func RejectInPlace[S ~[]T, T any](slice S, predicate func(value T, index int, slice S) bool) S {
+	return FilterInPlace(slice, func(value T, index int, slice S) bool { return !predicate(value, index, slice) })
+}
+
+// RemoveInPlace removes the elements of `slice` that `predicate` returns true for, keeping the order of
+// the rest. It works like `FilterInPlace` with the `predicate` inverted.
+func RemoveInPlace[S ~[]T, T any](slice S, predicate func(value T, index int, slice S) bool) S {
+	return RejectInPlace(slice, predicate)
+}
+
+// ReverseInPlace reverses the order of the elements in `slice` itself, and returns it.
+func ReverseInPlace[S ~[]T, T any](slice S) S {
+	for i, j := 0, len(slice)-1; i < j; i, j = i+1, j-1 {
+		slice[i], slice[j] = slice[j], slice[i]
+	}
+	return slice
+}
+
+// UniqInPlace removes all but the first occurrence of each element from `slice`, keeping their order.
+// Comparison is performed with `==`. The input slice is modified and should not be used afterwards;
+// use the returned slice instead.
+func UniqInPlace[S ~[]T, T comparable](slice S) S {
+	return UniqByInPlace(identity[T], slice)
+}
+
+// UniqByInPlace removes all but the first occurrence of each element from `slice`, keeping their order.
+// Comparison is performed with `==` on the result of passing each element through `iteratee`. The input
+// slice is modified and should not be used afterwards; use the returned slice instead.
+func UniqByInPlace[S ~[]T, T any, U comparable](iteratee func(T) U, slice S) S {
+	seen := make(map[U]struct{})
+	return FilterInPlace(slice, func(v T, _ int, _ S) bool {
+		key := iteratee(v)
+		if _, found := seen[key]; found {
+			return false
+		}
+		seen[key] = struct{}{}
+		return true
+	})
+}
+
+// WithoutInPlace removes all occurrences of the given `values` from `slice`, keeping the order of the
+// rest. Uses `==` for equality checks. The input slice is modified and should not be used afterwards;
+// use the returned slice instead.
+func WithoutInPlace[S ~[]T, T comparable](slice S, values ...T) S {
+	excluded := keySet(identity[T], values)
+	return RemoveInPlace(slice, func(v T, _ int, _ S) bool {
+		_, found := excluded[v]
+		return found
+	})
+}
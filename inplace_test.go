@@ -0,0 +1,202 @@
+package slicy
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestInPlaceZeroesTail(t *testing.T) {
+	a, b, c := new(int), new(int), new(int)
+	input := []*int{a, nil, b, a, c}
+	op := UniqInPlace(CompactInPlace(input))
+	if !reflect.DeepEqual(op, []*int{a, b, c}) {
+		t.Error(op)
+	}
+	if !reflect.DeepEqual(input[3:], []*int{nil, nil}) {
+		t.Error("expected the freed tail to be zeroed", input)
+	}
+	if &op[0] != &input[0] {
+		t.Error("expected the backing array to be reused")
+	}
+}
+
+func TestInPlaceMatchesAllocating(t *testing.T) {
+	isEven := func(v int, _ int, _ []int) bool { return v%2 == 0 }
+	inputs := [][]int{{}, {1}, {2, 2, 1, 0, 3, 0, 4, 1}, {0, 0, 0}}
+	for i, input := range inputs {
+		t.Run(fmt.Sprint(i), func(t *testing.T) {
+			cases := []struct {
+				name     string
+				inPlace  func([]int) []int
+				allocate func([]int) []int
+			}{
+				{"filter", func(s []int) []int { return FilterInPlace(s, isEven) }, func(s []int) []int { return Filter(s, isEven) }},
+				{"reject", func(s []int) []int { return RejectInPlace(s, isEven) }, func(s []int) []int { return Reject(s, isEven) }},
+				{"remove", func(s []int) []int { return RemoveInPlace(s, isEven) }, func(s []int) []int { return Remove(s, isEven) }},
+				{"without", func(s []int) []int { return WithoutInPlace(s, 0, 1) }, func(s []int) []int { return Without(s, 0, 1) }},
+				{"uniq", UniqInPlace[[]int], Uniq[[]int]},
+				{"reverse", ReverseInPlace[[]int], Reverse[[]int]},
+				{"compact", CompactInPlace[[]int], Compact[[]int]},
+			}
+			for _, c := range cases {
+				want := c.allocate(input)
+				if op := c.inPlace(append([]int{}, input...)); !reflect.DeepEqual(op, want) {
+					t.Error(c.name, want, op)
+				}
+			}
+		})
+	}
+}
+
+func ExampleCompact() {
+	fmt.Println(Compact([]string{"a", "", "b", ""}))
+	// Output:
+	// [a b]
+}
+
+func ExampleCompactInPlace() {
+	fmt.Println(CompactInPlace([]int{0, 1, 0, 2}))
+	// Output:
+	// [1 2]
+}
+
+func ExampleFilterInPlace() {
+	values := []int{1, 2, 3, 4, 5, 6}
+	evens := FilterInPlace(values, func(v int, _ int, _ []int) bool { return v%2 == 0 })
+	fmt.Println(evens)
+	fmt.Println(values)
+	// Output:
+	// [2 4 6]
+	// [2 4 6 0 0 0]
+}
+
+func ExampleRejectInPlace() {
+	fmt.Println(RejectInPlace([]int{1, 2, 3, 4, 5}, func(v int, _ int, _ []int) bool { return v%2 == 0 }))
+	// Output:
+	// [1 3 5]
+}
+
+func ExampleRemoveInPlace() {
+	fmt.Println(RemoveInPlace([]string{"a", "b", "c", "d"}, func(_ string, i int, _ []string) bool { return i%2 == 0 }))
+	// Output:
+	// [b d]
+}
+
+func ExampleReverseInPlace() {
+	values := []int{1, 2, 3}
+	ReverseInPlace(values)
+	fmt.Println(values)
+	// Output:
+	// [3 2 1]
+}
+
+func ExampleUniqInPlace() {
+	fmt.Println(UniqInPlace([]int{2, 1, 2}))
+	// Output:
+	// [2 1]
+}
+
+func ExampleUniqByInPlace() {
+	fmt.Println(UniqByInPlace(func(v int) int { return v * v }, []int{-1, 2, 1, -2}))
+	// Output:
+	// [-1 2]
+}
+
+func ExampleWithoutInPlace() {
+	fmt.Println(WithoutInPlace([]int{2, 1, 2, 3}, 1, 2))
+	// Output:
+	// [3]
+}
+
+func benchmarkInput() []int {
+	input := make([]int, 10000)
+	for i := range input {
+		input[i] = i % 100
+	}
+	return input
+}
+
+func BenchmarkFilter(b *testing.B) {
+	input := benchmarkInput()
+	buf := make([]int, len(input))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		copy(buf, input)
+		Filter(buf, func(v int, _ int, _ []int) bool { return v%2 == 0 })
+	}
+}
+
+func BenchmarkFilterInPlace(b *testing.B) {
+	input := benchmarkInput()
+	buf := make([]int, len(input))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		copy(buf, input)
+		FilterInPlace(buf, func(v int, _ int, _ []int) bool { return v%2 == 0 })
+	}
+}
+
+func BenchmarkUniqAllocating(b *testing.B) {
+	input := benchmarkInput()
+	buf := make([]int, len(input))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		copy(buf, input)
+		Uniq(buf)
+	}
+}
+
+func BenchmarkUniqInPlace(b *testing.B) {
+	input := benchmarkInput()
+	buf := make([]int, len(input))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		copy(buf, input)
+		UniqInPlace(buf)
+	}
+}
+
+func BenchmarkReverse(b *testing.B) {
+	input := benchmarkInput()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Reverse(input)
+	}
+}
+
+func BenchmarkReverseInPlace(b *testing.B) {
+	input := benchmarkInput()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ReverseInPlace(input)
+	}
+}
+
+func BenchmarkCompact(b *testing.B) {
+	input := benchmarkInput()
+	buf := make([]int, len(input))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		copy(buf, input)
+		Compact(buf)
+	}
+}
+
+func BenchmarkCompactInPlace(b *testing.B) {
+	input := benchmarkInput()
+	buf := make([]int, len(input))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		copy(buf, input)
+		CompactInPlace(buf)
+	}
+}
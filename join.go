@@ -0,0 +1,92 @@
+package slicy
+
+// The join functions match elements of a `left` and a `right` slice whose keys, computed by `leftKey`
+// and `rightKey`, are equal. They build a hash index of one side, so they run in linear time plus the
+// size of the output. An element that matches several elements on the other side appears once for each
+// match. Output follows the order of `left`, with the matches for each left element in the order of
+// `right`, and any unmatched right elements are added at the end in the order of `right`.
+
+// InnerJoin returns the result of `combine` for every pair of elements from `left` and `right` with equal keys.
+func InnerJoin[L, R any, K comparable, U any](left []L, right []R, leftKey func(L) K, rightKey func(R) K, combine func(L, R) U) []U {
+	index := GroupBy(right, rightKey)
+	output := make([]U, 0)
+	for _, l := range left {
+		for _, r := range index[leftKey(l)] {
+			output = append(output, combine(l, r))
+		}
+	}
+	return output
+}
+
+// LeftJoin is like `InnerJoin`, but also includes the elements of `left` that have no match in `right`,
+// passing them to `combine` with the zero value of `R` and `matched` set to false.
+func LeftJoin[L, R any, K comparable, U any](left []L, right []R, leftKey func(L) K, rightKey func(R) K, combine func(l L, r R, matched bool) U) []U {
+	return outerJoin(left, right, leftKey, rightKey, true, false, func(l L, r R, _, hasRight bool) U {
+		return combine(l, r, hasRight)
+	})
+}
+
+// RightJoin is like `InnerJoin`, but also includes the elements of `right` that have no match in `left`,
+// passing them to `combine` with the zero value of `L` and `matched` set to false.
+func RightJoin[L, R any, K comparable, U any](left []L, right []R, leftKey func(L) K, rightKey func(R) K, combine func(l L, r R, matched bool) U) []U {
+	return outerJoin(left, right, leftKey, rightKey, false, true, func(l L, r R, hasLeft, _ bool) U {
+		return combine(l, r, hasLeft)
+	})
+}
+
+// FullOuterJoin is like `InnerJoin`, but also includes the elements of either side that have no match on
+// the other. Missing sides are passed to `combine` as zero values, with `hasLeft` or `hasRight` set to false.
+func FullOuterJoin[L, R any, K comparable, U any](left []L, right []R, leftKey func(L) K, rightKey func(R) K, combine func(l L, r R, hasLeft, hasRight bool) U) []U {
+	return outerJoin(left, right, leftKey, rightKey, true, true, combine)
+}
+
+// outerJoin joins `left` and `right`, including unmatched left elements if `keepLeft` is set and unmatched
+// right elements if `keepRight` is set.
+func outerJoin[L, R any, K comparable, U any](left []L, right []R, leftKey func(L) K, rightKey func(R) K, keepLeft, keepRight bool, combine func(l L, r R, hasLeft, hasRight bool) U) []U {
+	index := make(map[K][]int)
+	for i, item := range right {
+		key := rightKey(item)
+		index[key] = append(index[key], i)
+	}
+	output := make([]U, 0)
+	matched := make([]bool, len(right))
+	var zeroL L
+	var zeroR R
+	for _, l := range left {
+		indexes := index[leftKey(l)]
+		if len(indexes) == 0 && keepLeft {
+			output = append(output, combine(l, zeroR, true, false))
+		}
+		for _, i := range indexes {
+			matched[i] = true
+			output = append(output, combine(l, right[i], true, true))
+		}
+	}
+	if keepRight {
+		for i, r := range right {
+			if !matched[i] {
+				output = append(output, combine(zeroL, r, false, true))
+			}
+		}
+	}
+	return output
+}
+
+// SemiJoin returns the elements of `left` that have at least one match in `right`, each included once,
+// in order.
+func SemiJoin[S ~[]L, L, R any, K comparable](left S, right []R, leftKey func(L) K, rightKey func(R) K) S {
+	keys := keySet(rightKey, right)
+	return Filter(left, func(l L, _ int, _ S) bool {
+		_, found := keys[leftKey(l)]
+		return found
+	})
+}
+
+// AntiJoin returns the elements of `left` that have no match in `right`, in order.
+func AntiJoin[S ~[]L, L, R any, K comparable](left S, right []R, leftKey func(L) K, rightKey func(R) K) S {
+	keys := keySet(rightKey, right)
+	return Reject(left, func(l L, _ int, _ S) bool {
+		_, found := keys[leftKey(l)]
+		return found
+	})
+}
@@ -0,0 +1,72 @@
+package slicy
+
+import (
+	"fmt"
+)
+
+type joinUser struct {
+	ID   int
+	Name string
+}
+
+type joinOrder struct {
+	UserID int
+	Item   string
+}
+
+var (
+	joinUsers  = []joinUser{{1, "ann"}, {2, "bob"}, {3, "cat"}}
+	joinOrders = []joinOrder{{3, "pen"}, {1, "cup"}, {4, "hat"}, {1, "mug"}}
+	userID     = func(u joinUser) int { return u.ID }
+	orderUser  = func(o joinOrder) int { return o.UserID }
+)
+
+func ExampleInnerJoin() {
+	fmt.Println(InnerJoin(joinUsers, joinOrders, userID, orderUser, func(u joinUser, o joinOrder) string {
+		return u.Name + ":" + o.Item
+	}))
+	// Output:
+	// [ann:cup ann:mug cat:pen]
+}
+
+func ExampleLeftJoin() {
+	fmt.Println(LeftJoin(joinUsers, joinOrders, userID, orderUser, func(u joinUser, o joinOrder, matched bool) string {
+		if !matched {
+			return u.Name + ":-"
+		}
+		return u.Name + ":" + o.Item
+	}))
+	// Output:
+	// [ann:cup ann:mug bob:- cat:pen]
+}
+
+func ExampleRightJoin() {
+	fmt.Println(RightJoin(joinUsers, joinOrders, userID, orderUser, func(u joinUser, o joinOrder, matched bool) string {
+		if !matched {
+			return "-:" + o.Item
+		}
+		return u.Name + ":" + o.Item
+	}))
+	// Output:
+	// [ann:cup ann:mug cat:pen -:hat]
+}
+
+func ExampleFullOuterJoin() {
+	fmt.Println(FullOuterJoin(joinUsers, joinOrders, userID, orderUser, func(u joinUser, o joinOrder, hasUser, hasOrder bool) string {
+		return fmt.Sprintf("%s(%t):%s(%t)", u.Name, hasUser, o.Item, hasOrder)
+	}))
+	// Output:
+	// [ann(true):cup(true) ann(true):mug(true) bob(true):(false) cat(true):pen(true) (false):hat(true)]
+}
+
+func ExampleSemiJoin() {
+	fmt.Println(SemiJoin(joinUsers, joinOrders, userID, orderUser))
+	// Output:
+	// [{1 ann} {3 cat}]
+}
+
+func ExampleAntiJoin() {
+	fmt.Println(AntiJoin(joinUsers, joinOrders, userID, orderUser))
+	// Output:
+	// [{2 bob}]
+}
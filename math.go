@@ -0,0 +1,125 @@
+package slicy
+
+import (
+	"math"
+
+	"golang.org/x/exp/constraints"
+	"golang.org/x/exp/slices"
+)
+
+// Number is the set of integer and floating point types the numeric functions work on.
+type Number interface {
+	constraints.Integer | constraints.Float
+}
+
+// Sum returns the total of all the numbers in `slice`. The sum of an empty slice is 0.
+func Sum[S ~[]T, T Number](slice S) T {
+	return SumBy(slice, identity[T])
+}
+
+// SumBy returns the total of the results of passing each element of `slice` through `iteratee`.
+// The sum of an empty slice is 0.
+func SumBy[S ~[]T, T any, U Number](slice S, iteratee func(T) U) (total U) {
+	for _, item := range slice {
+		total += iteratee(item)
+	}
+	return
+}
+
+// Mean returns the arithmetic mean of the numbers in `slice`. For an empty slice it returns false.
+func Mean[S ~[]T, T Number](slice S) (float64, bool) {
+	return MeanBy(slice, identity[T])
+}
+
+// MeanBy returns the arithmetic mean of the results of passing each element of `slice` through `iteratee`.
+// For an empty slice it returns false.
+func MeanBy[S ~[]T, T any, U Number](slice S, iteratee func(T) U) (float64, bool) {
+	if len(slice) == 0 {
+		return 0, false
+	}
+	total := 0.0
+	for _, item := range slice {
+		total += float64(iteratee(item))
+	}
+	return total / float64(len(slice)), true
+}
+
+// Min returns the smallest value in `slice`. For an empty slice it returns the zero value and false.
+func Min[S ~[]T, T constraints.Ordered](slice S) (T, bool) {
+	return MinBy(slice, identity[T])
+}
+
+// MinBy returns the element of `slice` with the smallest result from `iteratee`, which is called once
+// per element. The first such element is returned in case of ties. For an empty slice it returns the
+// zero value and false.
+func MinBy[S ~[]T, T any, U constraints.Ordered](slice S, iteratee func(T) U) (T, bool) {
+	return extremeBy(slice, iteratee, func(a, b U) bool { return a < b })
+}
+
+// Max returns the largest value in `slice`. For an empty slice it returns the zero value and false.
+func Max[S ~[]T, T constraints.Ordered](slice S) (T, bool) {
+	return MaxBy(slice, identity[T])
+}
+
+// MaxBy returns the element of `slice` with the largest result from `iteratee`, which is called once
+// per element. The first such element is returned in case of ties. For an empty slice it returns the
+// zero value and false.
+func MaxBy[S ~[]T, T any, U constraints.Ordered](slice S, iteratee func(T) U) (T, bool) {
+	return extremeBy(slice, iteratee, func(a, b U) bool { return a > b })
+}
+
+func extremeBy[S ~[]T, T any, U constraints.Ordered](slice S, iteratee func(T) U, better func(a, b U) bool) (result T, ok bool) {
+	if len(slice) == 0 {
+		return
+	}
+	result = slice[0]
+	best := iteratee(result)
+	for _, item := range slice[1:] {
+		if key := iteratee(item); better(key, best) {
+			result, best = item, key
+		}
+	}
+	return result, true
+}
+
+// Median returns the middle value of the numbers in `slice`, or the mean of the two middle values if
+// there is an even number of them. `slice` is not modified. For an empty slice it returns false.
+func Median[S ~[]T, T Number](slice S) (float64, bool) {
+	return Percentile(slice, 50)
+}
+
+// Percentile returns the value below which `p` percent of the numbers in `slice` fall, interpolating
+// linearly between the two closest values when needed. `slice` is not modified. For an empty slice,
+// or a `p` outside the range 0 to 100, it returns false.
+func Percentile[S ~[]T, T Number](slice S, p float64) (float64, bool) {
+	if len(slice) == 0 || p < 0 || p > 100 || math.IsNaN(p) {
+		return 0, false
+	}
+	sorted := slices.Clone(slice)
+	slices.Sort(sorted)
+	rank := p / 100 * float64(len(sorted)-1)
+	lower := int(math.Floor(rank))
+	upper := int(math.Ceil(rank))
+	fraction := rank - float64(lower)
+	return float64(sorted[lower]) + fraction*(float64(sorted[upper])-float64(sorted[lower])), true
+}
+
+// Variance returns the population variance of the numbers in `slice`: the mean of the squared
+// differences from their mean. For an empty slice it returns false.
+func Variance[S ~[]T, T Number](slice S) (float64, bool) {
+	mean, ok := Mean(slice)
+	if !ok {
+		return 0, false
+	}
+	return MeanBy(slice, func(v T) float64 {
+		d := float64(v) - mean
+		return d * d
+	})
+}
+
+// StdDev returns the population standard deviation of the numbers in `slice`, which is the square root
+// of their `Variance`. For an empty slice it returns false.
+func StdDev[S ~[]T, T Number](slice S) (float64, bool) {
+	variance, ok := Variance(slice)
+	return math.Sqrt(variance), ok
+}
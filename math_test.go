@@ -0,0 +1,122 @@
+package slicy
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestPercentile(t *testing.T) {
+	tests := []struct {
+		name string
+		i    []int
+		p    float64
+		o    float64
+		ok   bool
+	}{
+		{"empty", []int{}, 50, 0, false},
+		{"negative", []int{1}, -1, 0, false},
+		{"over", []int{1}, 101, 0, false},
+		{"nan", []int{1}, math.NaN(), 0, false},
+		{"single", []int{7}, 90, 7, true},
+		{"min", []int{3, 1, 2}, 0, 1, true},
+		{"max", []int{3, 1, 2}, 100, 3, true},
+		{"odd median", []int{3, 1, 2}, 50, 2, true},
+		{"even median", []int{4, 1, 3, 2}, 50, 2.5, true},
+		{"interpolated", []int{10, 20, 30, 40, 50}, 90, 46, true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			op, ok := Percentile(test.i, test.p)
+			if op != test.o || ok != test.ok {
+				t.Error(test.o, test.ok, op, ok)
+			}
+		})
+	}
+}
+
+func ExampleSum() {
+	fmt.Println(Sum([]int{1, 2, 3}))
+	fmt.Println(Sum([]float64{}))
+	// Output:
+	// 6
+	// 0
+}
+
+func ExampleSumBy() {
+	fmt.Println(SumBy([]string{"a", "bb", "ccc"}, func(s string) int { return len(s) }))
+	// Output:
+	// 6
+}
+
+func ExampleMean() {
+	fmt.Println(Mean([]int{1, 2, 3, 4}))
+	fmt.Println(Mean([]int{}))
+	// Output:
+	// 2.5 true
+	// 0 false
+}
+
+func ExampleMeanBy() {
+	fmt.Println(MeanBy([]string{"a", "bb", "ccc"}, func(s string) int { return len(s) }))
+	// Output:
+	// 2 true
+}
+
+func ExampleMin() {
+	fmt.Println(Min([]int{3, 1, 2}))
+	fmt.Println(Min([]int{}))
+	// Output:
+	// 1 true
+	// 0 false
+}
+
+func ExampleMax() {
+	fmt.Println(Max([]float64{3, 1.5, 2}))
+	// Output:
+	// 3 true
+}
+
+func ExampleMinBy() {
+	fmt.Println(MinBy([]float64{-3, 1, -1, 2}, math.Abs))
+	// Output:
+	// 1 true
+}
+
+func ExampleMaxBy() {
+	fmt.Println(MaxBy([]string{"bb", "a", "cc"}, func(s string) int { return len(s) }))
+	fmt.Println(MaxBy([]string{}, func(s string) int { return len(s) }))
+	// Output:
+	// bb true
+	//  false
+}
+
+func ExampleMedian() {
+	fmt.Println(Median([]int{5, 1, 3}))
+	fmt.Println(Median([]int{4, 1, 3, 2}))
+	// Output:
+	// 3 true
+	// 2.5 true
+}
+
+func ExamplePercentile() {
+	fmt.Println(Percentile([]int{10, 20, 30, 40, 50}, 90))
+	fmt.Println(Percentile([]int{10, 20, 30, 40, 50}, 150))
+	// Output:
+	// 46 true
+	// 0 false
+}
+
+func ExampleVariance() {
+	fmt.Println(Variance([]int{2, 4, 4, 4, 5, 5, 7, 9}))
+	// Output:
+	// 4 true
+}
+
+func ExampleStdDev() {
+	fmt.Println(StdDev([]int{2, 4, 4, 4, 5, 5, 7, 9}))
+	fmt.Println(StdDev([]int{}))
+	// Output:
+	// 2 true
+	// 0 false
+}
@@ -0,0 +1,129 @@
+package slicy
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// parallelIndexes calls `fn` once for every index in `[0, n)` using at most `concurrency` goroutines.
+// A `concurrency` less than 1 uses `runtime.GOMAXPROCS(0)` goroutines. If any call panics, no further
+// indexes are handed out and the first panic value is re-raised on the calling goroutine once all
+// workers have stopped.
+func parallelIndexes(n int, concurrency int, fn func(i int)) {
+	_ = parallelIndexesErr(context.Background(), n, concurrency, func(i int) error {
+		fn(i)
+		return nil
+	})
+}
+
+// parallelIndexesErr is like `parallelIndexes`, but also stops handing out indexes once `fn` returns an
+// error or `ctx` is done. Of the errors returned by `fn`, the one with the lowest index is returned as an
+// `*IndexError`. If `ctx` is done before all indexes are handed out, `ctx.Err()` is returned instead.
+func parallelIndexesErr(ctx context.Context, n int, concurrency int, fn func(i int) error) error {
+	if concurrency < 1 {
+		concurrency = runtime.GOMAXPROCS(0)
+	}
+	if concurrency > n {
+		concurrency = n
+	}
+	var (
+		next     atomic.Int64
+		stopped  atomic.Bool
+		mu       sync.Mutex
+		panicked any
+		failed   *IndexError
+		wg       sync.WaitGroup
+	)
+	wg.Add(concurrency)
+	for w := 0; w < concurrency; w++ {
+		go func() {
+			defer wg.Done()
+			defer func() {
+				if r := recover(); r != nil {
+					mu.Lock()
+					if panicked == nil {
+						panicked = r
+					}
+					mu.Unlock()
+					stopped.Store(true)
+				}
+			}()
+			for !stopped.Load() && ctx.Err() == nil {
+				i := int(next.Add(1) - 1)
+				if i >= n {
+					return
+				}
+				if err := fn(i); err != nil {
+					mu.Lock()
+					if failed == nil || i < failed.Index {
+						failed = &IndexError{Index: i, Err: err}
+					}
+					mu.Unlock()
+					stopped.Store(true)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	if panicked != nil {
+		panic(panicked)
+	}
+	if failed != nil {
+		return failed
+	}
+	if int(next.Load()) < n {
+		return ctx.Err()
+	}
+	return nil
+}
+
+// ParallelEach invokes the given `iteratee` for every element in the slice, using at most `concurrency`
+// goroutines. A `concurrency` less than 1 uses `runtime.GOMAXPROCS(0)`. There is no guarantee on the order
+// of invocation. If `iteratee` panics, the panic is re-raised on the calling goroutine.
+func ParallelEach[S ~[]T, T any](slice S, concurrency int, iteratee func(value T, index int, slice S)) {
+	parallelIndexes(len(slice), concurrency, func(i int) {
+		iteratee(slice[i], i, slice)
+	})
+}
+
+// ParallelFilter returns a slice of all elements that the `predicate` returns true for, in the same order as
+// `Filter`, with `predicate` invoked using at most `concurrency` goroutines. A `concurrency` less than 1 uses
+// `runtime.GOMAXPROCS(0)`. If `predicate` panics, the panic is re-raised on the calling goroutine.
+func ParallelFilter[S ~[]T, T any](slice S, concurrency int, predicate func(value T, index int, slice S) bool) S {
+	keep := make([]bool, len(slice))
+	parallelIndexes(len(slice), concurrency, func(i int) {
+		keep[i] = predicate(slice[i], i, slice)
+	})
+	output := make(S, 0)
+	for i, item := range slice {
+		if keep[i] {
+			output = append(output, item)
+		}
+	}
+	return output
+}
+
+// ParallelMap creates a slice of values by running each element in `slice` through `iteratee`, using at most
+// `concurrency` goroutines. A `concurrency` less than 1 uses `runtime.GOMAXPROCS(0)`. The output is in the
+// same order as `Map`. If `iteratee` panics, the panic is re-raised on the calling goroutine.
+func ParallelMap[S ~[]T, T any, U any](slice S, concurrency int, iteratee func(T) U) []U {
+	output := make([]U, len(slice))
+	parallelIndexes(len(slice), concurrency, func(i int) {
+		output[i] = iteratee(slice[i])
+	})
+	return output
+}
+
+// ParallelReduce splits `slice` into `concurrency` contiguous parts and reduces each of them concurrently
+// with `iteratee`, starting from `accumulator`. The partial results are then folded together, from left to
+// right, with `combine`. Since every part starts from `accumulator`, it should be an identity value for
+// `combine` (like `0` for addition), and `combine` should be associative. A `concurrency` less than 1 uses
+// `runtime.GOMAXPROCS(0)`. If `iteratee` panics, the panic is re-raised on the calling goroutine.
+func ParallelReduce[S ~[]T, T any, U any](slice S, concurrency int, iteratee func(acc U, value T, index int, slice S) U, combine func(U, U) U, accumulator U) U {
+	result, _ := ParallelReduceCtx(context.Background(), slice, concurrency, func(acc U, value T, index int, slice S) (U, error) {
+		return iteratee(acc, value, index, slice), nil
+	}, combine, accumulator)
+	return result
+}
@@ -0,0 +1,82 @@
+package slicy
+
+import (
+	"fmt"
+	"reflect"
+	"sync/atomic"
+	"testing"
+)
+
+func TestParallelMap(t *testing.T) {
+	input := make([]int, 1000)
+	for i := range input {
+		input[i] = i
+	}
+	square := func(n int) int { return n * n }
+	for _, concurrency := range []int{-1, 0, 1, 3, 8, 5000} {
+		t.Run(fmt.Sprint(concurrency), func(t *testing.T) {
+			op := ParallelMap(input, concurrency, square)
+			if want := Map(input, square); !reflect.DeepEqual(op, want) {
+				t.Error(want, op)
+			}
+		})
+	}
+}
+
+func TestParallelPanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r != "boom" {
+			t.Error("expected the worker panic to be re-raised, got", r)
+		}
+	}()
+	ParallelEach([]int{1, 2, 3, 4, 5}, 2, func(v int, _ int, _ []int) {
+		if v == 3 {
+			panic("boom")
+		}
+	})
+	t.Error("expected a panic")
+}
+
+func TestParallelReduce(t *testing.T) {
+	input := make([]int, 1001)
+	for i := range input {
+		input[i] = i
+	}
+	sum := func(acc int, v int, _ int, _ []int) int { return acc + v }
+	add := func(a, b int) int { return a + b }
+	for _, concurrency := range []int{0, 1, 3, 7, 2000} {
+		t.Run(fmt.Sprint(concurrency), func(t *testing.T) {
+			if op := ParallelReduce(input, concurrency, sum, add, 0); op != 500500 {
+				t.Error(500500, op)
+			}
+		})
+	}
+}
+
+func ExampleParallelEach() {
+	var total atomic.Int64
+	ParallelEach([]int64{1, 2, 3}, 2, func(v int64, _ int, _ []int64) { total.Add(v) })
+	fmt.Println(total.Load())
+	// Output:
+	// 6
+}
+
+func ExampleParallelFilter() {
+	fmt.Println(ParallelFilter([]int{1, 2, 3, 4, 5, 6}, 3, func(v int, _ int, _ []int) bool { return v%2 == 0 }))
+	// Output:
+	// [2 4 6]
+}
+
+func ExampleParallelMap() {
+	fmt.Println(ParallelMap([]int{4, 8, 16}, 2, func(n int) int { return n * n }))
+	// Output:
+	// [16 64 256]
+}
+
+func ExampleParallelReduce() {
+	fmt.Println(ParallelReduce([]string{"h", "e", "l", "l", "o"}, 2, func(acc string, v string, _ int, _ []string) string {
+		return acc + v
+	}, func(a, b string) string { return a + b }, ""))
+	// Output:
+	// hello
+}
@@ -0,0 +1,121 @@
+package slicy
+
+import (
+	"iter"
+	"math/rand/v2"
+)
+
+// intN returns a random int in `[0, n)` from `rng`, or from the global source if `rng` is nil.
+func intN(rng *rand.Rand, n int) int {
+	if rng == nil {
+		return rand.IntN(n)
+	}
+	return rng.IntN(n)
+}
+
+func float64n(rng *rand.Rand) float64 {
+	if rng == nil {
+		return rand.Float64()
+	}
+	return rng.Float64()
+}
+
+// Shuffle returns a new slice with the elements of `slice` in a random order, using a Fisher-Yates shuffle.
+// Randomness comes from `rng`, or from the global source if `rng` is nil; pass a seeded source for
+// reproducible results.
+func Shuffle[S ~[]T, T any](slice S, rng *rand.Rand) S {
+	output := make(S, len(slice))
+	copy(output, slice)
+	ShuffleInPlace(output, rng)
+	return output
+}
+
+// ShuffleInPlace randomly reorders the elements of `slice` itself, using a Fisher-Yates shuffle.
+// Randomness comes from `rng`, or from the global source if `rng` is nil.
+func ShuffleInPlace[S ~[]T, T any](slice S, rng *rand.Rand) {
+	for i := len(slice) - 1; i > 0; i-- {
+		j := intN(rng, i+1)
+		slice[i], slice[j] = slice[j], slice[i]
+	}
+}
+
+// Sample returns a random element of `slice`. For an empty slice it returns the zero value and false.
+// Randomness comes from `rng`, or from the global source if `rng` is nil.
+func Sample[S ~[]T, T any](slice S, rng *rand.Rand) (result T, ok bool) {
+	if len(slice) == 0 {
+		return
+	}
+	return slice[intN(rng, len(slice))], true
+}
+
+// SampleN returns a new slice of `n` distinct random elements of `slice`, chosen without replacement
+// and in random order. If `n` is larger than `slice`, all its elements are returned in random order.
+// Randomness comes from `rng`, or from the global source if `rng` is nil.
+func SampleN[S ~[]T, T any](slice S, n int, rng *rand.Rand) S {
+	if n > len(slice) {
+		n = len(slice)
+	}
+	if n < 0 {
+		n = 0
+	}
+	pool := make(S, len(slice))
+	copy(pool, slice)
+	// a partial Fisher-Yates shuffle only needs to settle the first n positions
+	for i := 0; i < n; i++ {
+		j := i + intN(rng, len(pool)-i)
+		pool[i], pool[j] = pool[j], pool[i]
+	}
+	return pool[:n:n]
+}
+
+// WeightedSample returns a random element of `slice`, with each element chosen with a probability
+// proportional to the result of passing it through `weight`. Elements with a weight of zero or less,
+// or of NaN, are never chosen. If `slice` is empty or no element has a positive weight, it returns the zero value
+// and false. Randomness comes from `rng`, or from the global source if `rng` is nil.
+func WeightedSample[S ~[]T, T any](slice S, weight func(T) float64, rng *rand.Rand) (result T, ok bool) {
+	weights := Map(slice, func(item T) float64 {
+		// the builtin max would pass a NaN through and poison the total
+		if w := weight(item); w > 0 {
+			return w
+		}
+		return 0
+	})
+	total := Sum(weights)
+	if total <= 0 {
+		return
+	}
+	target := float64n(rng) * total
+	last := -1
+	for i, w := range weights {
+		if w <= 0 {
+			continue
+		}
+		last = i
+		if target < w {
+			return slice[i], true
+		}
+		target -= w
+	}
+	// floating point error can leave a sliver of target past the final weight
+	return slice[last], true
+}
+
+// ReservoirSample returns up to `n` elements chosen uniformly at random from `seq`, which is read
+// exactly once without being held in memory, making it suitable for streams of unknown length.
+// Randomness comes from `rng`, or from the global source if `rng` is nil.
+func ReservoirSample[T any](seq iter.Seq[T], n int, rng *rand.Rand) []T {
+	reservoir := make([]T, 0, max(n, 0))
+	if n <= 0 {
+		return reservoir
+	}
+	seen := 0
+	for item := range seq {
+		seen++
+		if len(reservoir) < n {
+			reservoir = append(reservoir, item)
+		} else if j := intN(rng, seen); j < n {
+			reservoir[j] = item
+		}
+	}
+	return reservoir
+}
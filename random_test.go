@@ -0,0 +1,105 @@
+package slicy
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"reflect"
+	"testing"
+
+	"github.com/sudhirj/slicy/seq"
+)
+
+func TestShuffleIsDeterministic(t *testing.T) {
+	input := []int{1, 2, 3, 4, 5, 6, 7, 8}
+	a := Shuffle(input, rand.New(rand.NewPCG(1, 2)))
+	b := Shuffle(input, rand.New(rand.NewPCG(1, 2)))
+	if !reflect.DeepEqual(a, b) {
+		t.Error("expected the same shuffle from the same seed", a, b)
+	}
+	if !reflect.DeepEqual(input, []int{1, 2, 3, 4, 5, 6, 7, 8}) {
+		t.Error("input was modified", input)
+	}
+	if !reflect.DeepEqual(SortBy(a, Key(identity[int])), input) {
+		t.Error("expected a permutation of the input", a)
+	}
+}
+
+func TestSampleN(t *testing.T) {
+	rng := rand.New(rand.NewPCG(3, 4))
+	input := []int{1, 2, 3, 4, 5}
+	for n := -1; n <= 7; n++ {
+		op := SampleN(input, n, rng)
+		want := min(max(n, 0), len(input))
+		if len(op) != want || len(Uniq(op)) != want || len(Difference(op, input)) != 0 {
+			t.Error(n, op)
+		}
+	}
+}
+
+func TestWeightedSample(t *testing.T) {
+	rng := rand.New(rand.NewPCG(5, 6))
+	counts := map[string]int{}
+	weights := map[string]float64{"a": 1, "b": 3, "never": 0, "negative": -5, "nan": math.NaN()}
+	items := []string{"a", "b", "never", "negative", "nan"}
+	for i := 0; i < 4000; i++ {
+		item, _ := WeightedSample(items, func(s string) float64 { return weights[s] }, rng)
+		counts[item]++
+	}
+	if counts["never"] != 0 || counts["negative"] != 0 || counts["nan"] != 0 {
+		t.Error("non-positive weights were chosen", counts)
+	}
+	if counts["b"] < 2*counts["a"] {
+		t.Error("expected b to be chosen about three times as often as a", counts)
+	}
+	if _, ok := WeightedSample([]string{"x"}, func(string) float64 { return 0 }, rng); ok {
+		t.Error("expected no sample when all weights are zero")
+	}
+	if _, ok := WeightedSample([]string{"x", "y"}, func(string) float64 { return math.NaN() }, rng); ok {
+		t.Error("expected no sample when all weights are NaN")
+	}
+}
+
+func ExampleShuffle() {
+	rng := rand.New(rand.NewPCG(1, 2))
+	fmt.Println(len(Shuffle([]int{1, 2, 3, 4}, rng)))
+	// Output:
+	// 4
+}
+
+func ExampleSample() {
+	fmt.Println(Sample([]int{42}, nil))
+	fmt.Println(Sample([]int{}, nil))
+	// Output:
+	// 42 true
+	// 0 false
+}
+
+func ExampleSampleN() {
+	rng := rand.New(rand.NewPCG(1, 2))
+	fmt.Println(len(SampleN([]int{1, 2, 3, 4}, 2, rng)))
+	fmt.Println(len(SampleN([]int{1, 2, 3, 4}, 10, rng)))
+	// Output:
+	// 2
+	// 4
+}
+
+func ExampleWeightedSample() {
+	fmt.Println(WeightedSample([]string{"canary", "stable"}, func(s string) float64 {
+		if s == "canary" {
+			return 0
+		}
+		return 1
+	}, nil))
+	// Output:
+	// stable true
+}
+
+func ExampleReservoirSample() {
+	rng := rand.New(rand.NewPCG(1, 2))
+	fmt.Println(len(ReservoirSample(seq.FromSlice([]int{1, 2, 3, 4, 5, 6}), 3, rng)))
+	fmt.Println(ReservoirSample(seq.FromSlice([]int{1, 2}), 3, rng))
+	// Output:
+	// 3
+	// [1 2]
+}
@@ -0,0 +1,158 @@
+package seq
+
+import (
+	"iter"
+)
+
+// pick returns a new slice of the elements of `slice` at the given indexes.
+func pick[S ~[]T, T any](slice S, indexes []int) S {
+	output := make(S, len(indexes))
+	for i, index := range indexes {
+		output[i] = slice[index]
+	}
+	return output
+}
+
+// CartesianProduct yields every combination made by picking one element from each of the given slices, in
+// the order of nested loops with the last slice varying fastest. With no slices it yields a single empty
+// slice, and if any slice is empty it yields nothing. Every yielded slice is newly allocated.
+func CartesianProduct[S ~[]T, T any](slices ...S) iter.Seq[S] {
+	return func(yield func(S) bool) {
+		for _, slice := range slices {
+			if len(slice) == 0 {
+				return
+			}
+		}
+		indexes := make([]int, len(slices))
+		for {
+			output := make(S, len(slices))
+			for i, index := range indexes {
+				output[i] = slices[i][index]
+			}
+			if !yield(output) {
+				return
+			}
+			i := len(slices) - 1
+			for ; i >= 0; i-- {
+				indexes[i]++
+				if indexes[i] < len(slices[i]) {
+					break
+				}
+				indexes[i] = 0
+			}
+			if i < 0 {
+				return
+			}
+		}
+	}
+}
+
+// Combinations yields every way of choosing `k` elements from `slice`, keeping their order from `slice`.
+// Combinations are yielded in lexicographic order of their positions. A `k` of 0 yields a single empty
+// slice, and a `k` that is negative or larger than `slice` yields nothing. Every yielded slice is newly
+// allocated.
+func Combinations[S ~[]T, T any](slice S, k int) iter.Seq[S] {
+	return func(yield func(S) bool) {
+		n := len(slice)
+		if k < 0 || k > n {
+			return
+		}
+		indexes := make([]int, k)
+		for i := range indexes {
+			indexes[i] = i
+		}
+		for {
+			if !yield(pick(slice, indexes)) {
+				return
+			}
+			i := k - 1
+			for i >= 0 && indexes[i] == i+n-k {
+				i--
+			}
+			if i < 0 {
+				return
+			}
+			indexes[i]++
+			for j := i + 1; j < k; j++ {
+				indexes[j] = indexes[j-1] + 1
+			}
+		}
+	}
+}
+
+// CombinationsWithReplacement is like `Combinations`, but allows each element to be chosen more than once.
+// A `k` larger than `slice` is allowed, as long as `slice` is not empty.
+func CombinationsWithReplacement[S ~[]T, T any](slice S, k int) iter.Seq[S] {
+	return func(yield func(S) bool) {
+		n := len(slice)
+		if k < 0 || (n == 0 && k > 0) {
+			return
+		}
+		indexes := make([]int, k)
+		for {
+			if !yield(pick(slice, indexes)) {
+				return
+			}
+			i := k - 1
+			for i >= 0 && indexes[i] == n-1 {
+				i--
+			}
+			if i < 0 {
+				return
+			}
+			indexes[i]++
+			for j := i + 1; j < k; j++ {
+				indexes[j] = indexes[i]
+			}
+		}
+	}
+}
+
+// Permutations yields every ordered arrangement of `k` distinct elements of `slice`, in lexicographic order
+// of their positions. Elements are distinguished by position, not value, so duplicates in `slice` give
+// repeated permutations. A `k` of 0 yields a single empty slice, and a `k` that is negative or larger than
+// `slice` yields nothing. Every yielded slice is newly allocated.
+func Permutations[S ~[]T, T any](slice S, k int) iter.Seq[S] {
+	return func(yield func(S) bool) {
+		n := len(slice)
+		if k < 0 || k > n {
+			return
+		}
+		indexes := make([]int, k)
+		used := make([]bool, n)
+		var permute func(depth int) bool
+		permute = func(depth int) bool {
+			if depth == k {
+				return yield(pick(slice, indexes))
+			}
+			for i := 0; i < n; i++ {
+				if used[i] {
+					continue
+				}
+				used[i] = true
+				indexes[depth] = i
+				if !permute(depth + 1) {
+					return false
+				}
+				used[i] = false
+			}
+			return true
+		}
+		permute(0)
+	}
+}
+
+// PowerSet yields every subset of `slice`, keeping the order of elements from `slice`. Subsets are
+// yielded in order of size, starting with the empty slice, and then in the same order as `Combinations`.
+// Every yielded slice is newly allocated.
+func PowerSet[S ~[]T, T any](slice S) iter.Seq[S] {
+	return func(yield func(S) bool) {
+		for k := 0; k <= len(slice); k++ {
+			for subset := range Combinations(slice, k) {
+				if !yield(subset) {
+					return
+				}
+			}
+		}
+	}
+}
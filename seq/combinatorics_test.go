@@ -0,0 +1,43 @@
+package seq
+
+import (
+	"fmt"
+)
+
+func ExampleCartesianProduct() {
+	for combo := range CartesianProduct([]int{1, 2}, []int{3, 4}) {
+		fmt.Println(combo)
+	}
+	// Output:
+	// [1 3]
+	// [1 4]
+	// [2 3]
+	// [2 4]
+}
+
+func ExampleCombinations() {
+	fmt.Println(Collect(Take(Combinations([]int{1, 2, 3, 4, 5}, 3), 3)))
+	// Output:
+	// [[1 2 3] [1 2 4] [1 2 5]]
+}
+
+func ExampleCombinationsWithReplacement() {
+	fmt.Println(Collect(CombinationsWithReplacement([]int{1, 2}, 3)))
+	// Output:
+	// [[1 1 1] [1 1 2] [1 2 2] [2 2 2]]
+}
+
+func ExamplePermutations() {
+	// find the first ordering of the words that reads alphabetically
+	words := []string{"c", "a", "b"}
+	first := Find(Permutations(words, 3), func(p []string, _ int) bool { return p[0] < p[1] && p[1] < p[2] })
+	fmt.Println(first)
+	// Output:
+	// [a b c]
+}
+
+func ExamplePowerSet() {
+	fmt.Println(Collect(PowerSet([]string{"a", "b"})))
+	// Output:
+	// [[] [a] [b] [a b]]
+}
@@ -0,0 +1,762 @@
+// Package seq provides lazy counterparts to the slice functions in slicy, built on
+// `iter.Seq`. Nothing is computed until the sequence is ranged over, and stages stop
+// pulling from their source as soon as the consumer stops, so chaining `Filter`, `Map`
+// and `Take` does not allocate intermediate slices.
+//
+// Functions that take several inputs, like `Difference` or `Intersection`, consume the
+// first as a sequence and index the rest, which are slices. Functions that end a chain,
+// like `Every`, `GroupBy` or `Join`, consume the sequence and return an ordinary value.
+//
+// These slicy functions deliberately have no lazy version, since each of them needs random
+// access or must visit the elements from the end, so it would have to collect the whole
+// sequence first: `Reverse`, `EachRight`, `ReduceRight`, `DropRightWhile`, `TakeRightWhile`,
+// `Nth`, `Fill` and the `SortedIndex...` and `SortedLastIndex...` families. Collect the
+// sequence and use slicy instead.
+package seq
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"strings"
+)
+
+// ErrInvalidSize is raised as a panic by `Chunk` when the chunk size is less than 1. It is the
+// same value as `slicy.ErrInvalidSize`.
+var ErrInvalidSize = errors.New("slicy: size and step must be at least 1")
+
+// FromSlice returns a sequence that yields each element of `slice`, from left to right.
+func FromSlice[S ~[]T, T any](slice S) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, item := range slice {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// Collect drains `seq` into a new slice. An empty sequence results in an empty, non-nil slice.
+func Collect[T any](seq iter.Seq[T]) []T {
+	output := make([]T, 0)
+	for item := range seq {
+		output = append(output, item)
+	}
+	return output
+}
+
+// Enumerate returns a sequence of index and value pairs, with the index counting from 0.
+func Enumerate[T any](seq iter.Seq[T]) iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		for item := range seq {
+			if !yield(i, item) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+// Values returns a sequence of only the values from the given `seq` of pairs.
+func Values[K, V any](seq iter.Seq2[K, V]) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, v := range seq {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Chunk lazily splits `seq` into slices, each the length of `chunkSize`. If the sequence
+// cannot be split evenly, the last chunk will have the remaining elements. Each chunk is
+// a newly allocated slice. Panics with `ErrInvalidSize` if `chunkSize` is less than 1.
+func Chunk[T any](seq iter.Seq[T], chunkSize int) iter.Seq[[]T] {
+	if chunkSize < 1 {
+		panic(ErrInvalidSize)
+	}
+	return func(yield func([]T) bool) {
+		chunk := make([]T, 0, chunkSize)
+		for item := range seq {
+			chunk = append(chunk, item)
+			if len(chunk) == chunkSize {
+				if !yield(chunk) {
+					return
+				}
+				chunk = make([]T, 0, chunkSize)
+			}
+		}
+		if len(chunk) > 0 {
+			yield(chunk)
+		}
+	}
+}
+
+// Concat yields all the elements from all the given sequences, one after the other.
+func Concat[T any](seqs ...iter.Seq[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, seq := range seqs {
+			for item := range seq {
+				if !yield(item) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Difference yields the items in `seq` that are *not* present in any of the `others` slices.
+// The comparison is performed with `==`.
+func Difference[S ~[]T, T comparable](seq iter.Seq[T], others ...S) iter.Seq[T] {
+	return DifferenceBy(seq, identity[T], others...)
+}
+
+// DifferenceBy yields the items in `seq` that are *not* present in any of the `others` slices, with
+// the comparison made with `==` on the result of passing items through `iteratee`.
+func DifferenceBy[S ~[]T, T any, U comparable](seq iter.Seq[T], iteratee func(T) U, others ...S) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		excluded := keySet(iteratee, others...)
+		for item := range seq {
+			if _, found := excluded[iteratee(item)]; found {
+				continue
+			}
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// DifferenceWith yields the items in `seq` that are *not* present in any of the `others` slices, with
+// the comparison made using the given `comparator`. Every item is compared with every element of
+// `others`, so prefer `DifferenceBy` when the elements have a comparable key.
+func DifferenceWith[S ~[]T, T any](seq iter.Seq[T], comparator func(T, T) bool, others ...S) iter.Seq[T] {
+	return Reject(seq, func(item T, _ int) bool {
+		for _, other := range others {
+			for _, excluded := range other {
+				if comparator(item, excluded) {
+					return true
+				}
+			}
+		}
+		return false
+	})
+}
+
+// Drop skips `n` elements from the beginning of `seq` and yields the rest. Like `slicy.Drop`, a
+// negative `n` keeps only the last `-n` elements instead, which are held in a buffer of that size
+// and yielded once `seq` is exhausted.
+func Drop[T any](seq iter.Seq[T], n int) iter.Seq[T] {
+	if n < 0 {
+		return takeLast(seq, -n)
+	}
+	return func(yield func(T) bool) {
+		i := 0
+		for item := range seq {
+			if i < n {
+				i++
+				continue
+			}
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// DropRight skips `n` elements from the end of `seq` and yields the rest, holding back a buffer of
+// `n` elements until it is known which are last. If `n` is negative, only the first `-n` elements
+// are yielded.
+func DropRight[T any](seq iter.Seq[T], n int) iter.Seq[T] {
+	if n < 0 {
+		return Take(seq, -n)
+	}
+	return dropLast(seq, n)
+}
+
+// DropWhile skips elements from the beginning of `seq` until `predicate` returns false,
+// and yields the rest.
+func DropWhile[T any](seq iter.Seq[T], predicate func(value T, index int) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		dropping := true
+		i := 0
+		for item := range seq {
+			if dropping && predicate(item, i) {
+				i++
+				continue
+			}
+			dropping = false
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// Filter yields the elements of `seq` that `predicate` returns true for.
+func Filter[T any](seq iter.Seq[T], predicate func(value T, index int) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		i := 0
+		for item := range seq {
+			if predicate(item, i) && !yield(item) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+// FlatMap yields the flattened results of running each element in `seq` through `iteratee`.
+func FlatMap[T any, U any](seq iter.Seq[T], iteratee func(value T, index int) []U) iter.Seq[U] {
+	return func(yield func(U) bool) {
+		i := 0
+		for item := range seq {
+			for _, mapped := range iteratee(item, i) {
+				if !yield(mapped) {
+					return
+				}
+			}
+			i++
+		}
+	}
+}
+
+// Intersection yields the unique items of `seq` that are also present in all of the `others` slices,
+// in the order they occur in `seq`. Only `others` are indexed up front; `seq` is consumed lazily.
+func Intersection[S ~[]T, T comparable](seq iter.Seq[T], others ...S) iter.Seq[T] {
+	return IntersectionBy(seq, identity[T], others...)
+}
+
+// IntersectionBy is like `Intersection`, with the comparison made with `==` on the result of passing
+// items through `iteratee`.
+func IntersectionBy[S ~[]T, T any, U comparable](seq iter.Seq[T], iteratee func(T) U, others ...S) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		indexed := make([]map[U]struct{}, len(others))
+		for i, other := range others {
+			indexed[i] = keySet(iteratee, other)
+		}
+		for item := range UniqBy(seq, iteratee) {
+			if inAll(indexed, iteratee(item)) && !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// IntersectionWith is like `Intersection`, with the comparison made using the given `comparator`.
+// Every item is compared with the elements of `others` and with the items yielded so far.
+func IntersectionWith[S ~[]T, T any](seq iter.Seq[T], comparator func(T, T) bool, others ...S) iter.Seq[T] {
+	return Filter(UniqWith(seq, comparator), func(item T, _ int) bool {
+		for _, other := range others {
+			if !containsWith(other, item, comparator) {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// Map yields the result of running each element in `seq` through `iteratee`.
+func Map[T any, U any](seq iter.Seq[T], iteratee func(T) U) iter.Seq[U] {
+	return func(yield func(U) bool) {
+		for item := range seq {
+			if !yield(iteratee(item)) {
+				return
+			}
+		}
+	}
+}
+
+// Pull yields the elements of `seq` that are not any of the given `values`, like `Without`.
+func Pull[T comparable](seq iter.Seq[T], values ...T) iter.Seq[T] {
+	return Difference(seq, values)
+}
+
+// PullAll yields the elements of `seq` that are not in `values`.
+func PullAll[S ~[]T, T comparable](seq iter.Seq[T], values S) iter.Seq[T] {
+	return Difference(seq, values)
+}
+
+// PullAllBy yields the elements of `seq` that are not in `values`, with the comparison made with
+// `==` on the result of passing both through `iteratee`.
+func PullAllBy[S ~[]T, T any, U comparable](seq iter.Seq[T], values S, iteratee func(T) U) iter.Seq[T] {
+	return DifferenceBy(seq, iteratee, values)
+}
+
+// PullAllWith yields the elements of `seq` that are not in `values`, with the comparison made using
+// the given `comparator`.
+func PullAllWith[S ~[]T, T any](seq iter.Seq[T], values S, comparator func(T, T) bool) iter.Seq[T] {
+	return DifferenceWith(seq, comparator, values)
+}
+
+// PullAt yields the elements of `seq` that are not at any of the given `indexes`.
+func PullAt[T any](seq iter.Seq[T], indexes ...int) iter.Seq[T] {
+	excluded := make(map[int]struct{}, len(indexes))
+	for _, i := range indexes {
+		excluded[i] = struct{}{}
+	}
+	return Reject(seq, func(_ T, index int) bool {
+		_, found := excluded[index]
+		return found
+	})
+}
+
+// Reject yields the elements of `seq` that `predicate` returns false for.
+func Reject[T any](seq iter.Seq[T], predicate func(value T, index int) bool) iter.Seq[T] {
+	return Filter(seq, func(value T, index int) bool { return !predicate(value, index) })
+}
+
+// Remove yields the elements of `seq` that `predicate` returns false for, like `Reject`.
+func Remove[T any](seq iter.Seq[T], predicate func(value T, index int) bool) iter.Seq[T] {
+	return Reject(seq, predicate)
+}
+
+// Take yields at most `n` elements from the beginning of `seq`, and stops pulling from
+// `seq` once it has done so. Like `slicy.Take`, a negative `n` yields all but the last `-n`
+// elements instead, holding back a buffer of that size until it is known which are last.
+func Take[T any](seq iter.Seq[T], n int) iter.Seq[T] {
+	if n < 0 {
+		return dropLast(seq, -n)
+	}
+	return func(yield func(T) bool) {
+		if n <= 0 {
+			return
+		}
+		i := 0
+		for item := range seq {
+			if !yield(item) {
+				return
+			}
+			i++
+			if i >= n {
+				return
+			}
+		}
+	}
+}
+
+// TakeRight yields the last `n` elements of `seq` once it is exhausted, holding them in a buffer of
+// that size. If `n` is negative, all but the first `-n` elements are yielded instead.
+func TakeRight[T any](seq iter.Seq[T], n int) iter.Seq[T] {
+	if n < 0 {
+		return Drop(seq, -n)
+	}
+	return takeLast(seq, n)
+}
+
+// dropLast yields all but the last `n` elements of `seq`, each one as soon as `n` more have followed it.
+func dropLast[T any](seq iter.Seq[T], n int) iter.Seq[T] {
+	if n == 0 {
+		return seq
+	}
+	return func(yield func(T) bool) {
+		// buffer is a ring of the last n elements, with the oldest at position next once it is full
+		buffer := make([]T, 0, n)
+		next := 0
+		for item := range seq {
+			if len(buffer) < n {
+				buffer = append(buffer, item)
+				continue
+			}
+			oldest := buffer[next]
+			buffer[next] = item
+			next = (next + 1) % n
+			if !yield(oldest) {
+				return
+			}
+		}
+	}
+}
+
+// takeLast yields the last `n` elements of `seq` once it is exhausted.
+func takeLast[T any](seq iter.Seq[T], n int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if n == 0 {
+			return
+		}
+		buffer := make([]T, 0, n)
+		next := 0
+		for item := range seq {
+			if len(buffer) < n {
+				buffer = append(buffer, item)
+				continue
+			}
+			buffer[next] = item
+			next = (next + 1) % n
+		}
+		for _, item := range append(buffer[next:], buffer[:next]...) {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// TakeWhile yields elements from the beginning of `seq` until `predicate` returns false.
+func TakeWhile[T any](seq iter.Seq[T], predicate func(value T, index int) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		i := 0
+		for item := range seq {
+			if !predicate(item, i) || !yield(item) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+// Union yields the unique items of all the given `seqs`, in order, consuming each of them lazily.
+// Uses `==` for equality checks.
+func Union[T comparable](seqs ...iter.Seq[T]) iter.Seq[T] {
+	return UnionBy(identity[T], seqs...)
+}
+
+// UnionBy yields the unique items of all the given `seqs`, in order, using the result of the given
+// `iteratee` to check equality.
+func UnionBy[T any, U comparable](iteratee func(T) U, seqs ...iter.Seq[T]) iter.Seq[T] {
+	return UniqBy(Concat(seqs...), iteratee)
+}
+
+// UnionWith yields the unique items of all the given `seqs`, in order, using the given `comparator`
+// to check equality against every item yielded so far.
+func UnionWith[T any](comparator func(T, T) bool, seqs ...iter.Seq[T]) iter.Seq[T] {
+	return UniqWith(Concat(seqs...), comparator)
+}
+
+// Uniq yields the elements of `seq`, in order, with only the first occurrence of each element kept.
+// Comparison is performed with `==`.
+func Uniq[T comparable](seq iter.Seq[T]) iter.Seq[T] {
+	return UniqBy(seq, identity[T])
+}
+
+// UniqBy yields the elements of `seq`, in order, with only the first occurrence of each element kept.
+// Comparison is performed with `==` on the result of passing each element through the given `iteratee`.
+func UniqBy[T any, U comparable](seq iter.Seq[T], iteratee func(T) U) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		seen := make(map[U]struct{})
+		for item := range seq {
+			key := iteratee(item)
+			if _, found := seen[key]; found {
+				continue
+			}
+			seen[key] = struct{}{}
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// UniqWith yields the elements of `seq`, in order, with only the first occurrence of each element kept.
+// Comparison is performed with the given `comparator`, against every element kept so far.
+func UniqWith[T any](seq iter.Seq[T], comparator func(T, T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		kept := make([]T, 0)
+		for item := range seq {
+			if containsWith(kept, item, comparator) {
+				continue
+			}
+			kept = append(kept, item)
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// Without yields the elements of `seq` that are not any of the given `values`. Uses `==` for equality checks.
+func Without[T comparable](seq iter.Seq[T], values ...T) iter.Seq[T] {
+	return Difference(seq, values)
+}
+
+// Xor yields the unique values that are in `seq` or any of the `others` slices, but not in all of them,
+// which is the symmetric difference for two inputs. The items of `seq` are yielded lazily, first, followed
+// by those of `others`, which are indexed up front. Uses `==` for equality checks.
+func Xor[S ~[]T, T comparable](seq iter.Seq[T], others ...S) iter.Seq[T] {
+	return XorBy(seq, identity[T], others...)
+}
+
+// XorBy is like `Xor`, with the comparison made with `==` on the result of passing items through `iteratee`.
+func XorBy[S ~[]T, T any, U comparable](seq iter.Seq[T], iteratee func(T) U, others ...S) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		indexed := make([]map[U]struct{}, len(others))
+		for i, other := range others {
+			indexed[i] = keySet(iteratee, other)
+		}
+		// seen holds every key of seq, and of others once they are reached, yielded or not
+		seen := make(map[U]struct{})
+		for item := range seq {
+			key := iteratee(item)
+			if _, found := seen[key]; found {
+				continue
+			}
+			seen[key] = struct{}{}
+			if !inAll(indexed, key) && !yield(item) {
+				return
+			}
+		}
+		// anything in others that is not in seq cannot be in the intersection
+		for _, other := range others {
+			for _, item := range other {
+				key := iteratee(item)
+				if _, found := seen[key]; found {
+					continue
+				}
+				seen[key] = struct{}{}
+				if !yield(item) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// XorWith is like `Xor`, with the comparison made using the given `comparator`. Every item is compared
+// with the elements of `others` and with the items seen so far.
+func XorWith[S ~[]T, T any](seq iter.Seq[T], comparator func(T, T) bool, others ...S) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		seen := make([]T, 0)
+		inAllOthers := func(item T) bool {
+			for _, other := range others {
+				if !containsWith(other, item, comparator) {
+					return false
+				}
+			}
+			return true
+		}
+		for item := range seq {
+			if containsWith(seen, item, comparator) {
+				continue
+			}
+			seen = append(seen, item)
+			if !inAllOthers(item) && !yield(item) {
+				return
+			}
+		}
+		for _, other := range others {
+			for _, item := range other {
+				if containsWith(seen, item, comparator) {
+					continue
+				}
+				seen = append(seen, item)
+				if !yield(item) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Each invokes the given `iteratee` for every element in `seq`.
+func Each[T any](seq iter.Seq[T], iteratee func(value T, index int)) {
+	i := 0
+	for item := range seq {
+		iteratee(item, i)
+		i++
+	}
+}
+
+// Every returns true if the given `predicate` returns true for every element of `seq`.
+// It stops at the first element for which `predicate` returns false.
+func Every[T any](seq iter.Seq[T], predicate func(value T, index int) bool) bool {
+	i := 0
+	for item := range seq {
+		if !predicate(item, i) {
+			return false
+		}
+		i++
+	}
+	return true
+}
+
+// All is an alias for `Every`.
+func All[T any](seq iter.Seq[T], predicate func(value T, index int) bool) bool {
+	return Every(seq, predicate)
+}
+
+// Some returns true if the given `predicate` returns true for any element of `seq`.
+// It stops at the first element for which `predicate` returns true.
+func Some[T any](seq iter.Seq[T], predicate func(value T, index int) bool) bool {
+	i := 0
+	for item := range seq {
+		if predicate(item, i) {
+			return true
+		}
+		i++
+	}
+	return false
+}
+
+// Any is an alias for `Some`.
+func Any[T any](seq iter.Seq[T], predicate func(value T, index int) bool) bool {
+	return Some(seq, predicate)
+}
+
+// Find returns the first element of `seq` that `predicate` returns true for, or the zero
+// value if there is none.
+func Find[T any](seq iter.Seq[T], predicate func(value T, index int) bool) (result T) {
+	i := 0
+	for item := range seq {
+		if predicate(item, i) {
+			return item
+		}
+		i++
+	}
+	return
+}
+
+// FindIndex returns the index of the first element for which the `predicate` returns true,
+// or `-1` if there is none.
+func FindIndex[T any](seq iter.Seq[T], predicate func(T) bool) int {
+	i := 0
+	for item := range seq {
+		if predicate(item) {
+			return i
+		}
+		i++
+	}
+	return -1
+}
+
+// FindLastIndex returns the index of the last element for which the `predicate` returns true,
+// or `-1` if there is none. The whole of `seq` is consumed.
+func FindLastIndex[T any](seq iter.Seq[T], predicate func(T) bool) int {
+	last := -1
+	i := 0
+	for item := range seq {
+		if predicate(item) {
+			last = i
+		}
+		i++
+	}
+	return last
+}
+
+// IndexOf returns the index at which the first occurrence of `value` is found in `seq`, or `-1` if
+// there is none.
+func IndexOf[T comparable](seq iter.Seq[T], value T) int {
+	return FindIndex(seq, func(item T) bool { return item == value })
+}
+
+// LastIndexOf returns the index at which the last occurrence of `value` is found in `seq`, or `-1`
+// if there is none. The whole of `seq` is consumed.
+func LastIndexOf[T comparable](seq iter.Seq[T], value T) int {
+	return FindLastIndex(seq, func(item T) bool { return item == value })
+}
+
+// Includes checks if `value` is in `seq`. Equality is checked with `==`.
+func Includes[T comparable](seq iter.Seq[T], value T) bool {
+	for item := range seq {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
+
+// Join concatenates all the elements of `seq` into a string separated by `separator`, using
+// `fmt.Sprint` to get the string representation of each one.
+func Join[T any](seq iter.Seq[T], separator string) string {
+	var builder strings.Builder
+	for i, item := range Enumerate(seq) {
+		if i > 0 {
+			builder.WriteString(separator)
+		}
+		builder.WriteString(fmt.Sprint(item))
+	}
+	return builder.String()
+}
+
+// CountBy creates a map of the keys generated by running each element of `seq` through `iteratee`,
+// to the number of times each key was generated.
+func CountBy[T any, U comparable](seq iter.Seq[T], iteratee func(T) U) map[U]int {
+	output := make(map[U]int)
+	for item := range seq {
+		output[iteratee(item)]++
+	}
+	return output
+}
+
+// GroupBy creates a map of the keys generated by running each element of `seq` through `iteratee`,
+// to the elements that generated each key, in the order they occur in `seq`.
+func GroupBy[T any, U comparable](seq iter.Seq[T], iteratee func(T) U) map[U][]T {
+	output := make(map[U][]T)
+	for item := range seq {
+		key := iteratee(item)
+		output[key] = append(output[key], item)
+	}
+	return output
+}
+
+// KeyBy creates a map of the keys generated by running each element of `seq` through `iteratee`,
+// to the last element that generated each key.
+func KeyBy[T any, U comparable](seq iter.Seq[T], iteratee func(T) U) map[U]T {
+	output := make(map[U]T)
+	for item := range seq {
+		output[iteratee(item)] = item
+	}
+	return output
+}
+
+// Partition drains `seq` into two slices, the first with the elements that `predicate` returns
+// true for and the second with the rest.
+func Partition[T any](seq iter.Seq[T], predicate func(T) bool) (truths []T, falsehoods []T) {
+	truths = make([]T, 0)
+	falsehoods = make([]T, 0)
+	for item := range seq {
+		if predicate(item) {
+			truths = append(truths, item)
+		} else {
+			falsehoods = append(falsehoods, item)
+		}
+	}
+	return
+}
+
+// Reduce reduces `seq` to a value which is the accumulated result of running each element
+// through `iteratee`, where each successive invocation is supplied the return value of the
+// previous one. `accumulator` is used as the initial value.
+func Reduce[T any, U any](seq iter.Seq[T], iteratee func(acc U, value T, index int) U, accumulator U) U {
+	i := 0
+	for item := range seq {
+		accumulator = iteratee(accumulator, item, i)
+		i++
+	}
+	return accumulator
+}
+
+func identity[T any](v T) T {
+	return v
+}
+
+// keySet returns the set of keys produced by passing every element of `slices` through `iteratee`.
+func keySet[S ~[]T, T any, U comparable](iteratee func(T) U, slices ...S) map[U]struct{} {
+	output := make(map[U]struct{})
+	for _, slice := range slices {
+		for _, item := range slice {
+			output[iteratee(item)] = struct{}{}
+		}
+	}
+	return output
+}
+
+// inAll checks if `key` is in every one of the `sets`.
+func inAll[U comparable](sets []map[U]struct{}, key U) bool {
+	for _, set := range sets {
+		if _, found := set[key]; !found {
+			return false
+		}
+	}
+	return true
+}
+
+// containsWith checks if `comparator` returns true for `item` and any element of `slice`.
+func containsWith[S ~[]T, T any](slice S, item T, comparator func(T, T) bool) bool {
+	for _, v := range slice {
+		if comparator(v, item) {
+			return true
+		}
+	}
+	return false
+}
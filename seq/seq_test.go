@@ -0,0 +1,455 @@
+package seq
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestShortCircuit(t *testing.T) {
+	pulled := 0
+	source := func(yield func(int) bool) {
+		for i := 0; i < 1000000; i++ {
+			pulled++
+			if !yield(i) {
+				return
+			}
+		}
+	}
+	evens := Filter(source, func(v int, _ int) bool { return v%2 == 0 })
+	squares := Map(evens, func(v int) int { return v * v })
+	op := Collect(Take(squares, 3))
+	if !reflect.DeepEqual(op, []int{0, 4, 16}) {
+		t.Error(op)
+	}
+	if pulled != 5 {
+		t.Error("expected 5 elements to be pulled, got", pulled)
+	}
+}
+
+func TestChunk(t *testing.T) {
+	tests := []struct {
+		name string
+		i    []string
+		n    int
+		o    [][]string
+	}{
+		{"empty", []string{}, 3, [][]string{}},
+		{"round", []string{"a", "b", "c", "d"}, 2, [][]string{{"a", "b"}, {"c", "d"}}},
+		{"extra 1", []string{"a", "b", "c", "d"}, 3, [][]string{{"a", "b", "c"}, {"d"}}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			op := Collect(Chunk(FromSlice(test.i), test.n))
+			if !reflect.DeepEqual(op, test.o) {
+				t.Error(test.o, op)
+			}
+		})
+	}
+}
+
+func TestChunkInvalidSize(t *testing.T) {
+	defer func() {
+		if r := recover(); r != ErrInvalidSize {
+			t.Error("expected a panic with ErrInvalidSize, got", r)
+		}
+	}()
+	Chunk(FromSlice([]int{1, 2}), 0)
+}
+
+func ExampleFromSlice() {
+	for v := range FromSlice([]string{"a", "b"}) {
+		fmt.Println(v)
+	}
+	// Output:
+	// a
+	// b
+}
+
+func ExampleCollect() {
+	fmt.Println(Collect(FromSlice([]int{1, 2, 3})))
+	// Output:
+	// [1 2 3]
+}
+
+func ExampleEnumerate() {
+	for i, v := range Enumerate(FromSlice([]string{"a", "b"})) {
+		fmt.Println(i, v)
+	}
+	// Output:
+	// 0 a
+	// 1 b
+}
+
+func ExampleValues() {
+	fmt.Println(Collect(Values(Enumerate(FromSlice([]string{"a", "b"})))))
+	// Output:
+	// [a b]
+}
+
+func ExampleConcat() {
+	fmt.Println(Collect(Concat(FromSlice([]int{1, 2}), FromSlice([]int{3}))))
+	// Output:
+	// [1 2 3]
+}
+
+func ExampleDifference() {
+	fmt.Println(Collect(Difference(FromSlice([]int{1, 2, 3, 4, 5, 6, 7}), []int{0, 1, 2}, []int{5, 6, 7, 8})))
+	// Output:
+	// [3 4]
+}
+
+func ExampleDifferenceBy() {
+	fmt.Println(Collect(DifferenceBy(FromSlice([]float64{2.1, 1.2, 3.5}), math.Floor, []float64{2.3, 3.4})))
+	// Output:
+	// [1.2]
+}
+
+func ExampleDifferenceWith() {
+	sameLength := func(a, b string) bool { return len(a) == len(b) }
+	fmt.Println(Collect(DifferenceWith(FromSlice([]string{"a", "bb", "ccc"}), sameLength, []string{"xx"})))
+	// Output:
+	// [a ccc]
+}
+
+func ExampleDrop() {
+	fmt.Println(Collect(Drop(FromSlice([]int{1, 2, 3}), 1)))
+	fmt.Println(Collect(Drop(FromSlice([]int{1, 2, 3}), 5)))
+	fmt.Println(Collect(Drop(FromSlice([]int{1, 2, 3}), -2)))
+	// Output:
+	// [2 3]
+	// []
+	// [2 3]
+}
+
+func ExampleDropRight() {
+	fmt.Println(Collect(DropRight(FromSlice([]int{1, 2, 3}), 1)))
+	fmt.Println(Collect(DropRight(FromSlice([]int{1, 2, 3}), 5)))
+	fmt.Println(Collect(DropRight(FromSlice([]int{1, 2, 3}), -1)))
+	// Output:
+	// [1 2]
+	// []
+	// [1]
+}
+
+func ExampleDropWhile() {
+	haystack := []string{"h1", "h2", "needle", "h3", "needle"}
+	fmt.Println(Collect(DropWhile(FromSlice(haystack), func(item string, _ int) bool { return item != "needle" })))
+	// Output:
+	// [needle h3 needle]
+}
+
+func ExampleFilter() {
+	fmt.Println(Collect(Filter(FromSlice([]int{1, 2, 3, 4, 5, 6}), func(v int, _ int) bool { return v%2 == 0 })))
+	// Output:
+	// [2 4 6]
+}
+
+func ExampleFlatMap() {
+	fmt.Println(Collect(FlatMap(FromSlice([]int{1, 2}), func(v int, _ int) []int { return []int{v, v} })))
+	// Output:
+	// [1 1 2 2]
+}
+
+func ExampleIntersection() {
+	fmt.Println(Collect(Intersection(FromSlice([]int{2, 1, 2, 3}), []int{2, 3, 4}, []int{3, 2})))
+	fmt.Println(Collect(Intersection[[]int](FromSlice([]int{2, 1, 2}))))
+	// Output:
+	// [2 3]
+	// [2 1]
+}
+
+func ExampleIntersectionBy() {
+	fmt.Println(Collect(IntersectionBy(FromSlice([]float64{2.1, 1.2}), math.Floor, []float64{2.3, 3.4})))
+	// Output:
+	// [2.1]
+}
+
+func ExampleIntersectionWith() {
+	sameLength := func(a, b string) bool { return len(a) == len(b) }
+	fmt.Println(Collect(IntersectionWith(FromSlice([]string{"a", "bb", "cc", "ddd"}), sameLength, []string{"xx", "yyy"})))
+	// Output:
+	// [bb ddd]
+}
+
+func ExampleMap() {
+	fmt.Println(Collect(Map(FromSlice([]int{4, 8}), func(n int) int { return n * n })))
+	// Output:
+	// [16 64]
+}
+
+func ExamplePull() {
+	fmt.Println(Collect(Pull(FromSlice([]string{"a", "b", "c", "a"}), "a", "c")))
+	// Output:
+	// [b]
+}
+
+func ExamplePullAll() {
+	fmt.Println(Collect(PullAll(FromSlice([]string{"a", "b", "c", "a"}), []string{"a", "c"})))
+	// Output:
+	// [b]
+}
+
+func ExamplePullAllBy() {
+	fmt.Println(Collect(PullAllBy(FromSlice([]float64{2.1, 1.2, 3.5}), []float64{2.3, 3.4}, math.Floor)))
+	// Output:
+	// [1.2]
+}
+
+func ExamplePullAllWith() {
+	sameLength := func(a, b string) bool { return len(a) == len(b) }
+	fmt.Println(Collect(PullAllWith(FromSlice([]string{"a", "bb", "ccc"}), []string{"xx"}, sameLength)))
+	// Output:
+	// [a ccc]
+}
+
+func ExamplePullAt() {
+	fmt.Println(Collect(PullAt(FromSlice([]string{"a", "b", "c", "d"}), 1, 3)))
+	// Output:
+	// [a c]
+}
+
+func ExampleReject() {
+	fmt.Println(Collect(Reject(FromSlice([]int{1, 2, 3, 4, 5}), func(v int, _ int) bool { return v%2 == 0 })))
+	// Output:
+	// [1 3 5]
+}
+
+func ExampleRemove() {
+	fmt.Println(Collect(Remove(FromSlice([]int{1, 2, 3, 4}), func(v int, _ int) bool { return v%2 == 0 })))
+	// Output:
+	// [1 3]
+}
+
+func ExampleTake() {
+	fmt.Println(Collect(Take(FromSlice([]int{1, 2, 3}), 2)))
+	fmt.Println(Collect(Take(FromSlice([]int{1, 2, 3}), 0)))
+	fmt.Println(Collect(Take(FromSlice([]int{1, 2, 3}), -1)))
+	// Output:
+	// [1 2]
+	// []
+	// [1 2]
+}
+
+func ExampleTakeRight() {
+	fmt.Println(Collect(TakeRight(FromSlice([]int{1, 2, 3}), 2)))
+	fmt.Println(Collect(TakeRight(FromSlice([]int{1, 2, 3}), 0)))
+	fmt.Println(Collect(TakeRight(FromSlice([]int{1, 2, 3}), -1)))
+	// Output:
+	// [2 3]
+	// []
+	// [2 3]
+}
+
+func TestTakeDropNegative(t *testing.T) {
+	input := []int{1, 2, 3, 4}
+	tests := []struct {
+		name string
+		n    int
+		take []int
+		drop []int
+	}{
+		{"zero", 0, []int{}, []int{1, 2, 3, 4}},
+		{"minus one", -1, []int{1, 2, 3}, []int{4}},
+		{"minus three", -3, []int{1}, []int{2, 3, 4}},
+		{"minus length", -4, []int{}, []int{1, 2, 3, 4}},
+		{"beyond length", -9, []int{}, []int{1, 2, 3, 4}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if op := Collect(Take(FromSlice(input), test.n)); !reflect.DeepEqual(op, test.take) {
+				t.Error(test.take, op)
+			}
+			if op := Collect(Drop(FromSlice(input), test.n)); !reflect.DeepEqual(op, test.drop) {
+				t.Error(test.drop, op)
+			}
+		})
+	}
+	// dropping from the end still stops early when the consumer does
+	if op := Collect(Take(Take(FromSlice(input), -1), 2)); !reflect.DeepEqual(op, []int{1, 2}) {
+		t.Error([]int{1, 2}, op)
+	}
+}
+
+func ExampleTakeWhile() {
+	haystack := []string{"h1", "h2", "needle", "h3"}
+	fmt.Println(Collect(TakeWhile(FromSlice(haystack), func(item string, _ int) bool { return item != "needle" })))
+	// Output:
+	// [h1 h2]
+}
+
+func ExampleUnion() {
+	fmt.Println(Collect(Union(FromSlice([]int{2, 1, 2}), FromSlice([]int{3, 1}))))
+	// Output:
+	// [2 1 3]
+}
+
+func ExampleUnionBy() {
+	fmt.Println(Collect(UnionBy(math.Floor, FromSlice([]float64{2.1}), FromSlice([]float64{1.2, 2.3}))))
+	// Output:
+	// [2.1 1.2]
+}
+
+func ExampleUnionWith() {
+	sameLength := func(a, b string) bool { return len(a) == len(b) }
+	fmt.Println(Collect(UnionWith(sameLength, FromSlice([]string{"a", "bb"}), FromSlice([]string{"c", "ddd"}))))
+	// Output:
+	// [a bb ddd]
+}
+
+func ExampleUniq() {
+	fmt.Println(Collect(Uniq(FromSlice([]int{2, 1, 2}))))
+	// Output:
+	// [2 1]
+}
+
+func ExampleUniqBy() {
+	fmt.Println(Collect(UniqBy(FromSlice([]int{-1, 2, 1, -2}), func(v int) int { return v * v })))
+	// Output:
+	// [-1 2]
+}
+
+func ExampleUniqWith() {
+	sameLength := func(a, b string) bool { return len(a) == len(b) }
+	fmt.Println(Collect(UniqWith(FromSlice([]string{"a", "bb", "c", "ddd", "ee"}), sameLength)))
+	// Output:
+	// [a bb ddd]
+}
+
+func ExampleWithout() {
+	fmt.Println(Collect(Without(FromSlice([]int{2, 1, 2, 3}), 1, 2)))
+	// Output:
+	// [3]
+}
+
+func ExampleXor() {
+	fmt.Println(Collect(Xor(FromSlice([]int{2, 1, 2}), []int{2, 3})))
+	fmt.Println(Collect(Xor(FromSlice([]int{1}), []int{1}, []int{2})))
+	// Output:
+	// [1 3]
+	// [1 2]
+}
+
+func ExampleXorBy() {
+	fmt.Println(Collect(XorBy(FromSlice([]float64{2.1, 1.2}), math.Floor, []float64{2.3, 3.4})))
+	// Output:
+	// [1.2 3.4]
+}
+
+func ExampleXorWith() {
+	sameLength := func(a, b string) bool { return len(a) == len(b) }
+	fmt.Println(Collect(XorWith(FromSlice([]string{"a", "bb", "c"}), sameLength, []string{"xx", "yyy"})))
+	// Output:
+	// [a yyy]
+}
+
+func ExampleEvery() {
+	fmt.Println(Every(FromSlice([]int{2, 4}), func(v int, _ int) bool { return v%2 == 0 }))
+	fmt.Println(Every(FromSlice([]int{1, 2}), func(v int, _ int) bool { return v%2 == 0 }))
+	// Output:
+	// true
+	// false
+}
+
+func ExampleSome() {
+	fmt.Println(Some(FromSlice([]int{1, 2}), func(v int, _ int) bool { return v%2 == 0 }))
+	fmt.Println(Some(FromSlice([]int{1, 3}), func(v int, _ int) bool { return v%2 == 0 }))
+	// Output:
+	// true
+	// false
+}
+
+func ExampleAll() {
+	fmt.Println(All(FromSlice([]int{2, 4}), func(v int, _ int) bool { return v%2 == 0 }))
+	// Output:
+	// true
+}
+
+func ExampleAny() {
+	fmt.Println(Any(FromSlice([]int{1, 3}), func(v int, _ int) bool { return v%2 == 0 }))
+	// Output:
+	// false
+}
+
+func ExampleFind() {
+	fmt.Println(Find(FromSlice([]int{1, 2, 3, 4}), func(v int, _ int) bool { return v > 2 }))
+	// Output:
+	// 3
+}
+
+func ExampleFindIndex() {
+	fmt.Println(FindIndex(FromSlice([]string{"a", "b"}), func(v string) bool { return v == "b" }))
+	fmt.Println(FindIndex(FromSlice([]string{"a", "b"}), func(v string) bool { return v == "x" }))
+	// Output:
+	// 1
+	// -1
+}
+
+func ExampleFindLastIndex() {
+	fmt.Println(FindLastIndex(FromSlice([]int{1, 4, 2, 5}), func(v int) bool { return v%2 == 0 }))
+	fmt.Println(FindLastIndex(FromSlice([]int{1, 3}), func(v int) bool { return v%2 == 0 }))
+	// Output:
+	// 2
+	// -1
+}
+
+func ExampleIndexOf() {
+	fmt.Println(IndexOf(FromSlice([]string{"a", "b", "a"}), "a"))
+	fmt.Println(IndexOf(FromSlice([]string{"a", "b", "a"}), "x"))
+	// Output:
+	// 0
+	// -1
+}
+
+func ExampleLastIndexOf() {
+	fmt.Println(LastIndexOf(FromSlice([]string{"a", "b", "a"}), "a"))
+	// Output:
+	// 2
+}
+
+func ExampleIncludes() {
+	fmt.Println(Includes(FromSlice([]int{1, 2, 3}), 1))
+	fmt.Println(Includes(FromSlice([]int{1, 2, 3}), 42))
+	// Output:
+	// true
+	// false
+}
+
+func ExampleReduce() {
+	fmt.Println(Reduce(FromSlice([]int{1, 2, 3}), func(acc int, v int, _ int) int { return acc + v }, 0))
+	// Output:
+	// 6
+}
+
+func ExampleJoin() {
+	fmt.Println(Join(FromSlice([]int{1, 2, 3}), ", "))
+	fmt.Println(Join(FromSlice([]int{}), ", ") == "")
+	// Output:
+	// 1, 2, 3
+	// true
+}
+
+func ExampleCountBy() {
+	fmt.Println(CountBy(FromSlice([]float64{6.1, 4.2, 6.3}), math.Floor))
+	// Output:
+	// map[4:1 6:2]
+}
+
+func ExampleGroupBy() {
+	fmt.Println(GroupBy(FromSlice([]float64{6.1, 4.2, 6.3}), math.Floor))
+	// Output:
+	// map[4:[4.2] 6:[6.1 6.3]]
+}
+
+func ExampleKeyBy() {
+	fmt.Println(KeyBy(FromSlice([]string{"a", "bb", "cc"}), func(s string) int { return len(s) }))
+	// Output:
+	// map[1:a 2:cc]
+}
+
+func ExamplePartition() {
+	fmt.Println(Partition(FromSlice([]int{1, 2, 3, 4}), func(v int) bool { return v%2 == 0 }))
+	// Output:
+	// [2 4] [1 3]
+}
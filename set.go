@@ -0,0 +1,203 @@
+package slicy
+
+import (
+	"fmt"
+	"iter"
+
+	"golang.org/x/exp/constraints"
+	"golang.org/x/exp/slices"
+)
+
+// Set is a collection of unique values that remembers insertion order, with membership checked
+// using `==`. Since values are kept in the order they were first added, `Values` and the set
+// operations return values in the same order as the equivalent slice functions like
+// `Uniq`, `Union` and `Intersection`. The zero value is an empty set ready to use.
+type Set[T comparable] struct {
+	// items maps each value to its position in order
+	items map[T]int
+	// order holds the values in the order they were added, with gaps left by Remove
+	order []setEntry[T]
+}
+
+type setEntry[T any] struct {
+	value   T
+	removed bool
+}
+
+// NewSet creates a set containing the given `values`.
+func NewSet[T comparable](values ...T) *Set[T] {
+	s := &Set[T]{}
+	s.Add(values...)
+	return s
+}
+
+// Add adds the given `values` to the set. Values that are already present keep their original position.
+func (s *Set[T]) Add(values ...T) {
+	if s.items == nil {
+		s.items = make(map[T]int, len(values))
+	}
+	for _, v := range values {
+		if _, found := s.items[v]; !found {
+			s.items[v] = len(s.order)
+			s.order = append(s.order, setEntry[T]{value: v})
+		}
+	}
+}
+
+// Remove removes the given `values` from the set, ignoring those that are not present.
+func (s *Set[T]) Remove(values ...T) {
+	for _, v := range values {
+		if i, found := s.items[v]; found {
+			delete(s.items, v)
+			s.order[i] = setEntry[T]{removed: true}
+		}
+	}
+	// compact once at least half of order is gaps, so iterating stays linear in the size of the set
+	if len(s.order) > 2*len(s.items) {
+		s.compact()
+	}
+}
+
+// compact rebuilds order without the gaps left by Remove, into a new slice so that iterators already
+// ranging over the old one are not disturbed.
+func (s *Set[T]) compact() {
+	order := make([]setEntry[T], 0, len(s.items))
+	for _, entry := range s.order {
+		if !entry.removed {
+			s.items[entry.value] = len(order)
+			order = append(order, entry)
+		}
+	}
+	s.order = order
+}
+
+// Has checks if `value` is in the set.
+func (s *Set[T]) Has(value T) bool {
+	_, found := s.items[value]
+	return found
+}
+
+// Len returns the number of values in the set.
+func (s *Set[T]) Len() int {
+	return len(s.items)
+}
+
+// Clone returns a copy of the set.
+func (s *Set[T]) Clone() *Set[T] {
+	output := &Set[T]{items: make(map[T]int, len(s.items)), order: make([]setEntry[T], 0, len(s.items))}
+	for v := range s.All() {
+		output.items[v] = len(output.order)
+		output.order = append(output.order, setEntry[T]{value: v})
+	}
+	return output
+}
+
+// Values returns a new slice of the values in the set, in the order they were first added.
+func (s *Set[T]) Values() []T {
+	output := make([]T, 0, len(s.items))
+	for v := range s.All() {
+		output = append(output, v)
+	}
+	return output
+}
+
+// All returns an iterator over the values in the set, in the order they were first added.
+func (s *Set[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, entry := range s.order {
+			if !entry.removed && !yield(entry.value) {
+				return
+			}
+		}
+	}
+}
+
+// String formats the set like a slice of its values.
+func (s *Set[T]) String() string {
+	return fmt.Sprint(s.Values())
+}
+
+// Union returns a new set of the values in this set or any of the `others`, ordered like `Union`.
+func (s *Set[T]) Union(others ...*Set[T]) *Set[T] {
+	output := s.Clone()
+	for _, other := range others {
+		for v := range other.All() {
+			output.Add(v)
+		}
+	}
+	return output
+}
+
+// Intersection returns a new set of the values in this set that are also in all of the `others`,
+// ordered like `Intersection`.
+func (s *Set[T]) Intersection(others ...*Set[T]) *Set[T] {
+	output := NewSet[T]()
+	for v := range s.All() {
+		if All(others, func(other *Set[T], _ int, _ []*Set[T]) bool { return other.Has(v) }) {
+			output.Add(v)
+		}
+	}
+	return output
+}
+
+// Difference returns a new set of the values in this set that are not in any of the `others`,
+// ordered like `Difference`.
+func (s *Set[T]) Difference(others ...*Set[T]) *Set[T] {
+	output := NewSet[T]()
+	for v := range s.All() {
+		if !Some(others, func(other *Set[T], _ int, _ []*Set[T]) bool { return other.Has(v) }) {
+			output.Add(v)
+		}
+	}
+	return output
+}
+
+// SymmetricDifference returns a new set of the values that are in exactly one of this set and `other`,
+// ordered like `Xor`.
+func (s *Set[T]) SymmetricDifference(other *Set[T]) *Set[T] {
+	return s.Difference(other).Union(other.Difference(s))
+}
+
+// IsSubsetOf checks if every value in this set is also in `other`.
+func (s *Set[T]) IsSubsetOf(other *Set[T]) bool {
+	if s.Len() > other.Len() {
+		return false
+	}
+	for v := range s.items {
+		if !other.Has(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSupersetOf checks if every value in `other` is also in this set.
+func (s *Set[T]) IsSupersetOf(other *Set[T]) bool {
+	return other.IsSubsetOf(s)
+}
+
+// IsDisjoint checks if this set and `other` have no values in common.
+func (s *Set[T]) IsDisjoint(other *Set[T]) bool {
+	small, large := s, other
+	if small.Len() > large.Len() {
+		small, large = large, small
+	}
+	for v := range small.items {
+		if large.Has(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// Equal checks if this set and `other` contain exactly the same values, regardless of order.
+func (s *Set[T]) Equal(other *Set[T]) bool {
+	return s.Len() == other.Len() && s.IsSubsetOf(other)
+}
+
+// SortedValues returns a new slice of the values in `set`, sorted in ascending order.
+func SortedValues[T constraints.Ordered](set *Set[T]) []T {
+	output := set.Values()
+	slices.Sort(output)
+	return output
+}
@@ -0,0 +1,140 @@
+package slicy
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestSetOrderMatchesSliceFunctions(t *testing.T) {
+	a, b, c := []int{5, 1, 4, 1, 3}, []int{3, 9, 5, 7}, []int{7, 5, 3, 0}
+	sa, sb, sc := NewSet(a...), NewSet(b...), NewSet(c...)
+	tests := []struct {
+		name string
+		set  *Set[int]
+		o    []int
+	}{
+		{"uniq", sa, Uniq(a)},
+		{"union", sa.Union(sb, sc), Union(a, b, c)},
+		{"intersection", sa.Intersection(sb, sc), Intersection(a, b, c)},
+		{"difference", sa.Difference(sb, sc), Difference(Uniq(a), b, c)},
+		{"symmetric difference", sa.SymmetricDifference(sb), Xor(a, b)},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if op := test.set.Values(); !reflect.DeepEqual(op, test.o) {
+				t.Error(test.o, op)
+			}
+		})
+	}
+}
+
+func TestSetZeroValue(t *testing.T) {
+	var s Set[string]
+	if s.Has("a") || s.Len() != 0 {
+		t.Error("expected an empty set")
+	}
+	s.Add("a", "b", "a")
+	s.Remove("a", "z")
+	s.Add("a")
+	if op := s.Values(); !reflect.DeepEqual(op, []string{"b", "a"}) {
+		t.Error(op)
+	}
+}
+
+func TestSetRemoveKeepsOrder(t *testing.T) {
+	set := NewSet[int]()
+	expected := make([]int, 0)
+	for i := 0; i < 100; i++ {
+		set.Add(i)
+		expected = append(expected, i)
+		// remove most values again, so the set compacts several times along the way
+		if i%3 != 0 {
+			set.Remove(i - 1)
+			expected = Without(expected, i-1)
+		}
+	}
+	set.Add(1)
+	expected = append(Without(expected, 1), 1)
+	if op := set.Values(); !reflect.DeepEqual(op, expected) {
+		t.Error(expected, op)
+	}
+	if op := set.Clone().Values(); !reflect.DeepEqual(op, expected) {
+		t.Error(expected, op)
+	}
+	if len(set.order) > 2*set.Len() {
+		t.Error("set was not compacted", len(set.order), set.Len())
+	}
+}
+
+func ExampleNewSet() {
+	s := NewSet(3, 1, 3, 2)
+	fmt.Println(s, s.Len(), s.Has(1), s.Has(42))
+	// Output:
+	// [3 1 2] 3 true false
+}
+
+func ExampleSet_Union() {
+	fmt.Println(NewSet(2).Union(NewSet(1, 2), NewSet(2, 4, 6)))
+	// Output:
+	// [2 1 4 6]
+}
+
+func ExampleSet_Intersection() {
+	fmt.Println(NewSet(2, 1).Intersection(NewSet(2, 3), NewSet(8, 2)))
+	// Output:
+	// [2]
+}
+
+func ExampleSet_Difference() {
+	fmt.Println(NewSet(1, 2, 3, 4, 5, 6, 7).Difference(NewSet(0, 1, 2), NewSet(5, 6, 7, 8)))
+	// Output:
+	// [3 4]
+}
+
+func ExampleSet_SymmetricDifference() {
+	fmt.Println(NewSet(2, 1).SymmetricDifference(NewSet(2, 3)))
+	// Output:
+	// [1 3]
+}
+
+func ExampleSet_IsSubsetOf() {
+	fmt.Println(NewSet(1, 2).IsSubsetOf(NewSet(3, 2, 1)))
+	fmt.Println(NewSet(1, 4).IsSubsetOf(NewSet(3, 2, 1)))
+	fmt.Println(NewSet(3, 2, 1).IsSupersetOf(NewSet(1, 2)))
+	// Output:
+	// true
+	// false
+	// true
+}
+
+func ExampleSet_IsDisjoint() {
+	fmt.Println(NewSet(1, 2).IsDisjoint(NewSet(3, 4)))
+	fmt.Println(NewSet(1, 2).IsDisjoint(NewSet(2, 3)))
+	// Output:
+	// true
+	// false
+}
+
+func ExampleSet_Equal() {
+	fmt.Println(NewSet(1, 2).Equal(NewSet(2, 1)))
+	fmt.Println(NewSet(1, 2).Equal(NewSet(1, 2, 3)))
+	// Output:
+	// true
+	// false
+}
+
+func ExampleSet_All() {
+	for v := range NewSet("b", "a").All() {
+		fmt.Println(v)
+	}
+	// Output:
+	// b
+	// a
+}
+
+func ExampleSortedValues() {
+	fmt.Println(SortedValues(NewSet(3, 1, 2)))
+	// Output:
+	// [1 2 3]
+}
@@ -5,12 +5,17 @@ import (
 	"golang.org/x/exp/constraints"
 	"golang.org/x/exp/slices"
 	"math"
+	"sort"
 	"strings"
 )
 
 // Chunk splits the given slice into smaller slices, each the length of `chunkSize`.
 // If the slice cannot be split evenly, the last chunk will have the remaining elements.
+// Panics with `ErrInvalidSize` if `chunkSize` is less than 1.
 func Chunk[S ~[]T, T any](slice S, chunkSize int) []S {
+	if chunkSize < 1 {
+		panic(ErrInvalidSize)
+	}
 	chunks := int(math.Ceil(float64(len(slice)) / float64(chunkSize)))
 	output := make([]S, chunks)
 	for c := 0; c < chunks; c++ {
@@ -36,14 +41,21 @@ func Concat[S ~[]T, T any](slices ...S) S {
 // Difference returns a list of items present in `slice` that are *not* present in any of
 // the `others` slices. The comparison is performed with `==`.
 func Difference[S ~[]T, T comparable](slice S, others ...S) S {
-	return DifferenceWith(slice, func(x, y T) bool { return x == y }, others...)
+	return DifferenceBy(slice, identity[T], others...)
 }
 
 // DifferenceBy returns a list of items present in `slice` that are *not* present in any of
 // the `others` slices, with the comparison made by passing items into the `iteratee` function
 // and checking `==` on the result. This allows changing the way the item is viewed for comparison.
 func DifferenceBy[S ~[]T, T any, U comparable](slice S, iteratee func(T) U, others ...S) S {
-	return DifferenceWith(slice, func(x, y T) bool { return iteratee(x) == iteratee(y) }, others...)
+	excluded := keySet(iteratee, others...)
+	output := make(S, 0)
+	for _, item := range slice {
+		if _, found := excluded[iteratee(item)]; !found {
+			output = append(output, item)
+		}
+	}
+	return output
 }
 
 // DifferenceWith returns a slice of items present in `slice` that are *not* present in any of
@@ -62,19 +74,15 @@ func DifferenceWith[S ~[]T, T any](slice S, comparator func(T, T) bool, others .
 }
 
 // Drop returns a new slice with `n` elements dropped from the beginning.
+// If `n` is negative, only the last `-n` elements are kept.
 func Drop[S ~[]T, T any](slice S, n int) S {
-	if n > len(slice) {
-		n = len(slice)
-	}
-	return slice[n:]
+	return slice[relativeIndex(n, len(slice)):]
 }
 
 // DropRight returns a new slice with `n` elements dropped from the end.
+// If `n` is negative, only the first `-n` elements are kept.
 func DropRight[S ~[]T, T any](slice S, n int) S {
-	if n > len(slice) {
-		n = len(slice)
-	}
-	return slice[:len(slice)-n]
+	return slice[:fromEnd(n, len(slice))]
 }
 
 // DropRightWhile creates a new slice excluding elements dropped from the end.
@@ -104,7 +112,10 @@ func DropWhile[S ~[]T, T any](slice S, predicate func(value T, index int, slice
 }
 
 // Fill fills elements of `slice` with `value` from `start` up to, but not including `end`.
+// Negative indexes count back from the end of the slice, and out of range indexes are clamped,
+// like `Slice`.
 func Fill[S ~[]T, T any](slice S, value T, start int, end int) {
+	start, end = relativeIndex(start, len(slice)), relativeIndex(end, len(slice))
 	for i := start; i < end; i++ {
 		slice[i] = value
 	}
@@ -144,14 +155,35 @@ func IndexOf[S ~[]T, T comparable](slice S, value T) int {
 // Intersection returns a slice of unique values that are included in all given slices.
 // The order of the result values are determined by the first slice.
 func Intersection[S ~[]T, T comparable](slices ...S) S {
-	return IntersectionWith(func(x, y T) bool { return x == y }, slices...)
+	return IntersectionBy(identity[T], slices...)
 }
 
 // IntersectionBy returns a slice of unique values that are included in all given slices,
 // with comparison happening on the result of the `iteratee` function. The order of the result
 // values are determined by the first slice.
 func IntersectionBy[S ~[]T, T any, U comparable](iteratee func(T) U, others ...S) S {
-	return IntersectionWith(func(x, y T) bool { return iteratee(x) == iteratee(y) }, others...)
+	output := make(S, 0)
+	if len(others) == 0 {
+		return output
+	}
+	// every value in the intersection is in the first slice, so only the rest need to be indexed
+	rest := Map(others[1:], func(s S) map[U]struct{} { return keySet(iteratee, s) })
+	seen := make(map[U]struct{})
+	for _, item := range others[0] {
+		key := iteratee(item)
+		if _, found := seen[key]; found {
+			continue
+		}
+		seen[key] = struct{}{}
+		inAll := All(rest, func(set map[U]struct{}, _ int, _ []map[U]struct{}) bool {
+			_, found := set[key]
+			return found
+		})
+		if inAll {
+			output = append(output, item)
+		}
+	}
+	return output
 }
 
 // IntersectionWith returns a slice of unique values that are included in all given slice,
@@ -265,6 +297,39 @@ func Reverse[S ~[]T, T any](slice S) S {
 	return output
 }
 
+// relativeIndex turns `i` into an index in `[0, length]`, with negative values counting back from `length`
+// and out of range values clamped.
+func relativeIndex(i int, length int) int {
+	if i < 0 {
+		i += length
+	}
+	return min(max(i, 0), length)
+}
+
+// fromEnd returns the index `n` places back from `length`, with negative values counting forward from 0
+// instead, clamped to `[0, length]`.
+func fromEnd(n int, length int) int {
+	if n < 0 {
+		return min(-n, length)
+	}
+	return max(length-n, 0)
+}
+
+func identity[T any](v T) T {
+	return v
+}
+
+// keySet returns the set of keys produced by passing every item in all the given slices through `iteratee`.
+func keySet[S ~[]T, T any, U comparable](iteratee func(T) U, slices ...S) map[U]struct{} {
+	set := make(map[U]struct{})
+	for _, slice := range slices {
+		for _, item := range slice {
+			set[iteratee(item)] = struct{}{}
+		}
+	}
+	return set
+}
+
 func cmp[T constraints.Ordered](a, b T) int {
 	if a == b {
 		return 0
@@ -298,56 +363,40 @@ func SortedIndexOf[S ~[]T, T constraints.Ordered](slice S, value T) int {
 	return k
 }
 
-// SortedLastIndex returns the highest index at which `value` should be inserted into the sorted `slice` to maintain
-// its sort order.
+// SortedLastIndex uses a binary search to determine the highest index at which `value` should be inserted into
+// the sorted `slice` to maintain its sort order.
 func SortedLastIndex[S ~[]T, T constraints.Ordered](slice S, value T) int {
-	i := SortedIndex(slice, value)
-	// we now want the next index that has a bigger value in the remaining sub-slice
-	j := FindIndex(slice[i:], func(v T) bool { return v > value })
-	if j == -1 {
-		return len(slice)
-	}
-	return i + j
+	return sort.Search(len(slice), func(i int) bool { return slice[i] > value })
 }
 
-// SortedLastIndexBy returns the highest index at which `value` should be inserted into the sorted `slice` to maintain
-// its sort order, with comparisons made on the result of passing all values through `iteratee`.
+// SortedLastIndexBy uses a binary search to determine the highest index at which `value` should be inserted into
+// the sorted `slice` to maintain its sort order, with comparisons made on the result of passing all values through
+// `iteratee`.
 func SortedLastIndexBy[S ~[]T, T any, U constraints.Ordered](slice S, value T, iteratee func(T) U) int {
-	i := SortedIndexBy(slice, value, iteratee)
-	j := FindIndex(slice[i:], func(v T) bool { return iteratee(v) > iteratee(value) })
-	if j == -1 {
-		return len(slice)
-	}
-	return i + j
+	key := iteratee(value)
+	return sort.Search(len(slice), func(i int) bool { return iteratee(slice[i]) > key })
 }
 
-// SortedLastIndexOf returns the highest index at which the `value` is present in the sorted `slice`.
+// SortedLastIndexOf performs a binary search on a sorted `slice` to find the highest index at which `value` is
+// present. Returns -1 if not found.
 func SortedLastIndexOf[S ~[]T, T constraints.Ordered](slice S, value T) int {
-	i := SortedIndexOf(slice, value)
-	if i == -1 {
-		return i
-	}
-	j := FindIndex(slice[i:], func(v T) bool { return v > value })
-	if j == -1 {
-		return i
+	i := SortedLastIndex(slice, value)
+	if i == 0 || slice[i-1] != value {
+		return -1
 	}
-	return i + j - 1
+	return i - 1
 }
 
 // Take returns a new slice with `n` elements taken from the beginning.
+// If `n` is negative, all but the last `-n` elements are taken.
 func Take[S ~[]T, T any](slice S, n int) S {
-	if n > len(slice) {
-		n = len(slice)
-	}
-	return slice[:n]
+	return slice[:relativeIndex(n, len(slice))]
 }
 
 // TakeRight returns a new slice with `n` elements taken from the end.
+// If `n` is negative, all but the first `-n` elements are taken.
 func TakeRight[S ~[]T, T any](slice S, n int) S {
-	if n > len(slice) {
-		n = len(slice)
-	}
-	return slice[len(slice)-n:]
+	return slice[fromEnd(n, len(slice)):]
 }
 
 // TakeRightWhile creates a slice of elements taken from the end of `slice`.
@@ -378,13 +427,24 @@ func TakeWhile[S ~[]T, T any](slice S, predicate func(value T, index int, slice
 
 // Union creates a new slice, in order, of unique values of all the given slices. Uses `==` for equality checks.
 func Union[S ~[]T, T comparable](slices ...S) S {
-	return UnionWith(func(a, b T) bool { return a == b }, slices...)
+	return UnionBy(identity[T], slices...)
 }
 
 // UnionBy creates a new slice, in order, of unique values of all the given slices.
 // Uses the result of the given `iteratee` to check equality.
 func UnionBy[S ~[]T, T any, U comparable](iteratee func(T) U, slices ...S) S {
-	return UnionWith(func(a, b T) bool { return iteratee(a) == iteratee(b) }, slices...)
+	output := make(S, 0)
+	seen := make(map[U]struct{})
+	for _, slice := range slices {
+		for _, item := range slice {
+			key := iteratee(item)
+			if _, found := seen[key]; !found {
+				seen[key] = struct{}{}
+				output = append(output, item)
+			}
+		}
+	}
+	return output
 }
 
 // UnionWith creates a new slice, in order, of unique values of all the given slices.
@@ -421,20 +481,14 @@ func UniqWith[S ~[]T, T any](comparator func(T, T) bool, slice S) S {
 
 // Without returns a new slice without the given elements. Uses `==` for equality checks.
 func Without[S ~[]T, T comparable](slice S, values ...T) S {
-	output := make(S, 0)
-	for _, e := range slice {
-		if slices.Index(values, e) == -1 {
-			output = append(output, e)
-		}
-	}
-	return output
+	return Difference(slice, values)
 }
 
 // Xor returns a new slice of unique values that is the symmetric difference
 // (elements which are any of the sets but not in their intersection) of the given slices.
 // The order of result values is determined by the order they occur in the slices.
 func Xor[S ~[]T, T comparable](slices ...S) S {
-	return XorWith(func(a, b T) bool { return a == b }, slices...)
+	return XorBy(identity[T], slices...)
 }
 
 // XorBy returns a new slice of unique values that is the symmetric difference
@@ -442,7 +496,22 @@ func Xor[S ~[]T, T comparable](slices ...S) S {
 // The order of result values is determined by the order they occur in the slices.
 // Equality is determined by passing elements through the given `iteratee`.
 func XorBy[S ~[]T, T any, U comparable](iteratee func(T) U, slices ...S) S {
-	return XorWith(func(a, b T) bool { return iteratee(a) == iteratee(b) }, slices...)
+	intersection := keySet(iteratee, IntersectionBy(iteratee, slices...))
+	output := make(S, 0)
+	seen := make(map[U]struct{})
+	for _, slice := range slices {
+		for _, item := range slice {
+			key := iteratee(item)
+			if _, found := intersection[key]; found {
+				continue
+			}
+			if _, found := seen[key]; !found {
+				seen[key] = struct{}{}
+				output = append(output, item)
+			}
+		}
+	}
+	return output
 }
 
 // XorWith returns a new slice of unique values that is the symmetric difference
@@ -465,7 +534,7 @@ func XorWith[S ~[]T, T any](comparator func(T, T) bool, sliceList ...S) S {
 
 // CountBy creates a map composed of keys generated from the results of running each element
 // of the slice through `iteratee`. The corresponding value of each key is the number
-// of times the key was returned by `iteratee`.
+// of times the key was returned by `iteratee`. Use `CountByOrdered` to get the keys in a stable order.
 func CountBy[S ~[]T, T any, U comparable](slice S, iteratee func(T) U) map[U]int {
 	output := make(map[U]int)
 	for _, item := range slice {
@@ -556,7 +625,7 @@ func FlatMap[S ~[]T, T any, U any](slice S, iteratee func(value T, index int, sl
 // GroupBy creates a map composed of keys generated from the results of running each element
 // of `slice` through `iteratee`. The order of the grouped values is determined by the order
 // that they occur in `slice`. The corresponding value of each key is a slice of elements
-// responsible for generating the key.
+// responsible for generating the key. Use `GroupByOrdered` to get the keys in a stable order.
 func GroupBy[S ~[]T, T any, U comparable](slice S, iteratee func(T) U) map[U]S {
 	output := make(map[U]S)
 	for _, item := range slice {
@@ -573,7 +642,7 @@ func Includes[S ~[]T, T comparable](slice S, value T) bool {
 
 // KeyBy creates a map composed of keys generated from the results of running each element
 // of `slice` through `iteratee`. The corresponding value of each key is the last element
-// responsible for generating the key.
+// responsible for generating the key. Use `KeyByOrdered` to get the keys in a stable order.
 func KeyBy[S ~[]T, T any, U comparable](slice S, iteratee func(T) U) map[U]T {
 	output := make(map[U]T)
 	for _, item := range slice {
@@ -219,8 +219,11 @@ func ExampleFill() {
 	array := []string{"a", "b", "c", "d"}
 	Fill(array, "*", 1, 3)
 	fmt.Println(array)
+	Fill(array, "-", -1, 10)
+	fmt.Println(array)
 	// Output:
 	// [a * * d]
+	// [a * * -]
 }
 
 func ExampleFindIndex() {
@@ -667,3 +670,47 @@ func ExampleReject() {
 	// Output:
 	// [1 3 5]
 }
+
+func TestHashedSetFunctionsMatchComparators(t *testing.T) {
+	eq := func(a, b int) bool { return a == b }
+	inputs := [][][]int{
+		{},
+		{{}},
+		{{1, 2, 2, 3}},
+		{{2, 1, 2, 3, 1}, {3, 2, 5}},
+		{{5, 4, 3, 2, 1}, {1, 3, 5, 7}, {5, 1, 9, 3}},
+		{{1, 1, 1}, {}, {1}},
+	}
+	for i, slices := range inputs {
+		t.Run(fmt.Sprint(i), func(t *testing.T) {
+			if op, want := Intersection(slices...), IntersectionWith(eq, slices...); !reflect.DeepEqual(op, want) {
+				t.Error("Intersection", want, op)
+			}
+			if op, want := Union(slices...), UnionWith(eq, slices...); !reflect.DeepEqual(op, want) {
+				t.Error("Union", want, op)
+			}
+			if op, want := Xor(slices...), XorWith(eq, slices...); !reflect.DeepEqual(op, want) {
+				t.Error("Xor", want, op)
+			}
+			if len(slices) > 0 {
+				if op, want := Difference(slices[0], slices[1:]...), DifferenceWith(slices[0], eq, slices[1:]...); !reflect.DeepEqual(op, want) {
+					t.Error("Difference", want, op)
+				}
+				if op, want := Uniq(slices[0]), UniqWith(eq, slices[0]); !reflect.DeepEqual(op, want) {
+					t.Error("Uniq", want, op)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkUniq(b *testing.B) {
+	ids := make([]int, 200000)
+	for i := range ids {
+		ids[i] = i % 1000
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Uniq(ids)
+	}
+}
@@ -0,0 +1,63 @@
+package slicy
+
+import (
+	"golang.org/x/exp/constraints"
+	"golang.org/x/exp/slices"
+)
+
+// Direction is the order in which `OrderBy` sorts a key.
+type Direction int
+
+const (
+	Ascending Direction = iota
+	Descending
+)
+
+// SortKey is a key to sort by with `SortBy` or `OrderBy`, created with `Key`.
+type SortKey[T any] struct {
+	// column computes the key for every item once, and returns a comparison between the keys at two indexes.
+	column func(items []T) func(i, j int) int
+}
+
+// Key creates a `SortKey` that ranks items by the result of passing them through `iteratee`.
+// Keys of different types can be combined in the same sort.
+func Key[T any, U constraints.Ordered](iteratee func(T) U) SortKey[T] {
+	return SortKey[T]{column: func(items []T) func(i, j int) int {
+		keys := Map(items, iteratee)
+		return func(i, j int) int { return cmp(keys[i], keys[j]) }
+	}}
+}
+
+// SortBy returns a new slice with the elements of `slice` stably sorted in ascending order by each of
+// the given `keys` in turn, with later keys used to break ties in earlier ones. Each key is computed
+// only once per element, and `slice` is not modified.
+func SortBy[S ~[]T, T any](slice S, keys ...SortKey[T]) S {
+	return OrderBy(slice, keys, nil)
+}
+
+// OrderBy is like `SortBy`, but sorts each key in the matching `Direction` from `directions`.
+// Keys without a matching direction are sorted in ascending order.
+func OrderBy[S ~[]T, T any](slice S, keys []SortKey[T], directions []Direction) S {
+	columns := Map(keys, func(key SortKey[T]) func(i, j int) int { return key.column(slice) })
+	indexes := make([]int, len(slice))
+	for i := range indexes {
+		indexes[i] = i
+	}
+	slices.SortStableFunc(indexes, func(i, j int) bool {
+		for k, compare := range columns {
+			c := compare(i, j)
+			if k < len(directions) && directions[k] == Descending {
+				c = -c
+			}
+			if c != 0 {
+				return c < 0
+			}
+		}
+		return false
+	})
+	output := make(S, len(slice))
+	for i, index := range indexes {
+		output[i] = slice[index]
+	}
+	return output
+}
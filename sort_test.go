@@ -0,0 +1,55 @@
+package slicy
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestSortByComputesKeysOnce(t *testing.T) {
+	calls := 0
+	input := []int{5, 3, 8, 1, 9, 2, 7}
+	op := SortBy(input, Key(func(n int) int {
+		calls++
+		return n
+	}))
+	if !reflect.DeepEqual(op, []int{1, 2, 3, 5, 7, 8, 9}) {
+		t.Error(op)
+	}
+	if calls != len(input) {
+		t.Error("expected", len(input), "key calls, got", calls)
+	}
+	if !reflect.DeepEqual(input, []int{5, 3, 8, 1, 9, 2, 7}) {
+		t.Error("input was modified", input)
+	}
+}
+
+func ExampleSortBy() {
+	type user struct {
+		name string
+		age  int
+	}
+	users := []user{{"fred", 48}, {"barney", 36}, {"fred", 40}, {"barney", 34}}
+	fmt.Println(SortBy(users, Key(func(u user) string { return u.name })))
+	fmt.Println(SortBy(users, Key(func(u user) string { return u.name }), Key(func(u user) int { return u.age })))
+	fmt.Println(SortBy([]float64{-3, 1, -2}, Key(math.Abs)))
+	// Output:
+	// [{barney 36} {barney 34} {fred 48} {fred 40}]
+	// [{barney 34} {barney 36} {fred 40} {fred 48}]
+	// [1 -2 -3]
+}
+
+func ExampleOrderBy() {
+	type user struct {
+		name string
+		age  int
+	}
+	users := []user{{"fred", 48}, {"barney", 34}, {"fred", 40}, {"barney", 36}}
+	keys := []SortKey[user]{Key(func(u user) string { return u.name }), Key(func(u user) int { return u.age })}
+	fmt.Println(OrderBy(users, keys, []Direction{Ascending, Descending}))
+	fmt.Println(OrderBy(users, keys, []Direction{Descending}))
+	// Output:
+	// [{barney 36} {barney 34} {fred 48} {fred 40}]
+	// [{fred 40} {fred 48} {barney 34} {barney 36}]
+}
@@ -0,0 +1,105 @@
+package slicy
+
+import (
+	"golang.org/x/exp/constraints"
+)
+
+// The functions in this file expect slices that are already sorted in ascending order, and use that to
+// run in O(log n) or O(n+m) time without hashing. Their results are undefined for unsorted input.
+// `MergeSorted` combines any number of sorted slices into one.
+
+// InsertSorted returns a new slice with `value` inserted into the sorted `slice` after any equal elements,
+// so that the result stays sorted. The position is found with a binary search.
+func InsertSorted[S ~[]T, T constraints.Ordered](slice S, value T) S {
+	i := SortedLastIndex(slice, value)
+	output := make(S, len(slice)+1)
+	copy(output, slice[:i])
+	output[i] = value
+	copy(output[i+1:], slice[i:])
+	return output
+}
+
+// RemoveSorted returns a new slice with all occurrences of `value` removed from the sorted `slice`. The
+// occurrences are found with a binary search.
+func RemoveSorted[S ~[]T, T constraints.Ordered](slice S, value T) S {
+	start, end := SortedIndex(slice, value), SortedLastIndex(slice, value)
+	output := make(S, 0, len(slice)-(end-start))
+	output = append(output, slice[:start]...)
+	return append(output, slice[end:]...)
+}
+
+// UniqSorted returns a new slice with only the first occurrence of each element of the sorted `slice`.
+func UniqSorted[S ~[]T, T constraints.Ordered](slice S) S {
+	output := make(S, 0)
+	for i, item := range slice {
+		if i == 0 || item != slice[i-1] {
+			output = append(output, item)
+		}
+	}
+	return output
+}
+
+// IntersectSorted is like `Intersection` for two sorted slices: it returns the unique values present in
+// both `a` and `b`, in sorted order, in a single pass over both.
+func IntersectSorted[S ~[]T, T constraints.Ordered](a, b S) S {
+	output := make(S, 0)
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		switch {
+		case a[i] < b[j]:
+			i++
+		case b[j] < a[i]:
+			j++
+		default:
+			output = appendUniqSorted(output, a[i])
+			i, j = i+1, j+1
+		}
+	}
+	return output
+}
+
+// UnionSorted is like `Union` for two sorted slices: it returns the unique values present in either `a` or
+// `b`, in sorted order, in a single pass over both.
+func UnionSorted[S ~[]T, T constraints.Ordered](a, b S) S {
+	output := make(S, 0)
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		if b[j] < a[i] {
+			output = appendUniqSorted(output, b[j])
+			j++
+		} else {
+			output = appendUniqSorted(output, a[i])
+			i++
+		}
+	}
+	for _, item := range a[i:] {
+		output = appendUniqSorted(output, item)
+	}
+	for _, item := range b[j:] {
+		output = appendUniqSorted(output, item)
+	}
+	return output
+}
+
+// DifferenceSorted is like `Difference` for two sorted slices: it returns the elements of `a` that are not
+// present in `b`, in sorted order, in a single pass over both. Repeated elements of `a` are kept.
+func DifferenceSorted[S ~[]T, T constraints.Ordered](a, b S) S {
+	output := make(S, 0)
+	j := 0
+	for _, item := range a {
+		for j < len(b) && b[j] < item {
+			j++
+		}
+		if j == len(b) || b[j] != item {
+			output = append(output, item)
+		}
+	}
+	return output
+}
+
+// appendUniqSorted appends `value` to the sorted `output` unless it is already the last element.
+func appendUniqSorted[S ~[]T, T constraints.Ordered](output S, value T) S {
+	if len(output) > 0 && output[len(output)-1] == value {
+		return output
+	}
+	return append(output, value)
+}
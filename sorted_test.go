@@ -0,0 +1,96 @@
+package slicy
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"reflect"
+	"testing"
+
+	"golang.org/x/exp/slices"
+)
+
+func ExampleInsertSorted() {
+	fmt.Println(InsertSorted([]int{1, 3, 3, 5}, 3))
+	fmt.Println(InsertSorted([]int{1, 3, 5}, 6))
+	fmt.Println(InsertSorted([]int{}, 1))
+	// Output:
+	// [1 3 3 3 5]
+	// [1 3 5 6]
+	// [1]
+}
+
+func ExampleRemoveSorted() {
+	fmt.Println(RemoveSorted([]int{1, 3, 3, 5}, 3))
+	fmt.Println(RemoveSorted([]int{1, 3, 5}, 4))
+	// Output:
+	// [1 5]
+	// [1 3 5]
+}
+
+func ExampleUniqSorted() {
+	fmt.Println(UniqSorted([]int{1, 1, 2, 3, 3, 3}))
+	fmt.Println(UniqSorted([]int{}))
+	// Output:
+	// [1 2 3]
+	// []
+}
+
+func ExampleIntersectSorted() {
+	fmt.Println(IntersectSorted([]int{1, 2, 2, 3, 5}, []int{2, 2, 3, 4, 5}))
+	// Output:
+	// [2 3 5]
+}
+
+func ExampleUnionSorted() {
+	fmt.Println(UnionSorted([]int{1, 2, 2, 5}, []int{2, 3, 6, 6}))
+	// Output:
+	// [1 2 3 5 6]
+}
+
+func ExampleDifferenceSorted() {
+	fmt.Println(DifferenceSorted([]int{1, 2, 2, 3, 4, 4}, []int{2, 4, 5}))
+	// Output:
+	// [1 3]
+}
+
+func TestSortedSetFunctionsMatchHashed(t *testing.T) {
+	rng := rand.New(rand.NewPCG(3, 4))
+	random := func() []int {
+		values := make([]int, rng.IntN(20))
+		for i := range values {
+			values[i] = rng.IntN(10)
+		}
+		slices.Sort(values)
+		return values
+	}
+	sorted := func(values []int) []int {
+		output := append([]int{}, values...)
+		slices.Sort(output)
+		return output
+	}
+	for n := 0; n < 100; n++ {
+		a, b := random(), random()
+		tests := []struct {
+			name string
+			op   []int
+			o    []int
+		}{
+			{"UniqSorted", UniqSorted(a), Uniq(a)},
+			{"IntersectSorted", IntersectSorted(a, b), Intersection(a, b)},
+			{"UnionSorted", UnionSorted(a, b), sorted(Union(a, b))},
+			{"DifferenceSorted", DifferenceSorted(a, b), Difference(a, b)},
+			{"MergeSorted", MergeSorted(a, b), sorted(append(append([]int{}, a...), b...))},
+		}
+		for _, test := range tests {
+			if !reflect.DeepEqual(test.op, test.o) {
+				t.Error(test.name, a, b, test.o, test.op)
+			}
+		}
+		for v := -1; v <= 10; v++ {
+			o := len(Filter(a, func(x int, _ int, _ []int) bool { return x <= v }))
+			if op := SortedLastIndex(a, v); op != o {
+				t.Error("SortedLastIndex", a, v, o, op)
+			}
+		}
+	}
+}
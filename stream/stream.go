@@ -0,0 +1,263 @@
+// Package stream provides channel-based counterparts to the slice functions in slicy, for
+// data that arrives over time instead of all at once. Each stage starts a goroutine that
+// reads from its input channel and closes its output channel when the input is closed or
+// the context is cancelled.
+//
+// All stages of a pipeline should be given the same context: cancelling it stops every
+// stage, so that no goroutine is left blocked on a channel nobody reads from. A consumer
+// that stops reading before the final output is closed must cancel the context.
+package stream
+
+import (
+	"context"
+	"reflect"
+	"time"
+)
+
+// send delivers `value` on `out`, returning false if `ctx` is done first.
+func send[T any](ctx context.Context, out chan<- T, value T) bool {
+	select {
+	case out <- value:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// receive reads the next value from `in`, returning false if `in` is closed or `ctx` is done first.
+func receive[T any](ctx context.Context, in <-chan T) (value T, ok bool) {
+	select {
+	case value, ok = <-in:
+		return value, ok
+	case <-ctx.Done():
+		return value, false
+	}
+}
+
+// FromSlice returns a channel that delivers each element of `slice`, from left to right, and is then closed.
+func FromSlice[S ~[]T, T any](ctx context.Context, slice S) <-chan T {
+	out := make(chan T)
+	go func() {
+		defer close(out)
+		for _, item := range slice {
+			if !send(ctx, out, item) {
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// ToSlice reads `in` until it is closed and returns the values it delivered. If `ctx` is done by the
+// time reading stops, it returns the values read so far along with the context's error, since the
+// stages feeding `in` may have stopped early.
+func ToSlice[T any](ctx context.Context, in <-chan T) ([]T, error) {
+	output := make([]T, 0)
+	for {
+		item, ok := receive(ctx, in)
+		if !ok {
+			return output, ctx.Err()
+		}
+		output = append(output, item)
+	}
+}
+
+// Map returns a channel that delivers the result of running each value from `in` through `iteratee`.
+func Map[T any, U any](ctx context.Context, in <-chan T, iteratee func(T) U) <-chan U {
+	out := make(chan U)
+	go func() {
+		defer close(out)
+		for {
+			item, ok := receive(ctx, in)
+			if !ok || !send(ctx, out, iteratee(item)) {
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// Filter returns a channel that delivers only the values from `in` that `predicate` returns true for.
+// The `index` passed to `predicate` counts the values read from `in`, starting from 0.
+func Filter[T any](ctx context.Context, in <-chan T, predicate func(value T, index int) bool) <-chan T {
+	out := make(chan T)
+	go func() {
+		defer close(out)
+		for i := 0; ; i++ {
+			item, ok := receive(ctx, in)
+			if !ok {
+				return
+			}
+			if predicate(item, i) && !send(ctx, out, item) {
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// Batch is a streaming `Chunk`: it returns a channel that delivers the values from `in` grouped into
+// slices. A batch is delivered once it holds `size` values, or once `interval` has passed since its
+// first value arrived, whichever comes first. A `size` less than 1 removes the size bound and an
+// `interval` of zero or less removes the time bound. Any remaining values are delivered as a final,
+// shorter batch when `in` is closed. Each batch is a newly allocated slice.
+func Batch[T any](ctx context.Context, in <-chan T, size int, interval time.Duration) <-chan []T {
+	out := make(chan []T)
+	go func() {
+		defer close(out)
+		var batch []T
+		var timer *time.Timer
+		var deadline <-chan time.Time
+		defer func() {
+			if timer != nil {
+				timer.Stop()
+			}
+		}()
+		flush := func() bool {
+			if timer != nil {
+				timer.Stop()
+				deadline = nil
+			}
+			if len(batch) == 0 {
+				return true
+			}
+			full := batch
+			batch = nil
+			return send(ctx, out, full)
+		}
+		for {
+			select {
+			case item, ok := <-in:
+				if !ok {
+					flush()
+					return
+				}
+				batch = append(batch, item)
+				if len(batch) == 1 && interval > 0 {
+					if timer == nil {
+						timer = time.NewTimer(interval)
+					} else {
+						timer.Reset(interval)
+					}
+					deadline = timer.C
+				}
+				if len(batch) == size && !flush() {
+					return
+				}
+			case <-deadline:
+				deadline = nil
+				if !flush() {
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// Merge returns a channel that delivers the values from all of `ins` as they arrive, and is closed once
+// all of them are closed. Values from each input keep their relative order.
+func Merge[T any](ctx context.Context, ins ...<-chan T) <-chan T {
+	out := make(chan T)
+	done := make(chan struct{})
+	for _, in := range ins {
+		go func() {
+			defer func() { done <- struct{}{} }()
+			for {
+				item, ok := receive(ctx, in)
+				if !ok || !send(ctx, out, item) {
+					return
+				}
+			}
+		}()
+	}
+	go func() {
+		defer close(out)
+		for range ins {
+			<-done
+		}
+	}()
+	return out
+}
+
+// FanOut returns `n` channels that share the values from `in` between them, each value being delivered
+// on exactly one of them, whichever is read from first. It spreads work over several consumers. An `n`
+// less than 1 is treated as 1.
+func FanOut[T any](ctx context.Context, in <-chan T, n int) []<-chan T {
+	outs := make([]<-chan T, max(n, 1))
+	for i := range outs {
+		out := make(chan T)
+		outs[i] = out
+		go func() {
+			defer close(out)
+			for {
+				item, ok := receive(ctx, in)
+				if !ok || !send(ctx, out, item) {
+					return
+				}
+			}
+		}()
+	}
+	return outs
+}
+
+// Tee returns `n` channels that each deliver every value from `in`, in order. A value is not read from
+// `in` until the previous one has been delivered on every output, so all outputs must be read from, or
+// the context cancelled, for the stream to make progress. An `n` less than 1 is treated as 1.
+func Tee[T any](ctx context.Context, in <-chan T, n int) []<-chan T {
+	outs := make([]<-chan T, max(n, 1))
+	sends := make([]chan T, len(outs))
+	for i := range outs {
+		sends[i] = make(chan T)
+		outs[i] = sends[i]
+	}
+	go func() {
+		defer func() {
+			for _, out := range sends {
+				close(out)
+			}
+		}()
+		cases := make([]reflect.SelectCase, len(sends)+1)
+		cases[len(sends)] = reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(ctx.Done())}
+		for {
+			item, ok := receive(ctx, in)
+			if !ok {
+				return
+			}
+			value := reflect.ValueOf(&item).Elem()
+			for i, out := range sends {
+				cases[i] = reflect.SelectCase{Dir: reflect.SelectSend, Chan: reflect.ValueOf(out), Send: value}
+			}
+			for pending := len(sends); pending > 0; pending-- {
+				chosen, _, _ := reflect.Select(cases)
+				if chosen == len(sends) {
+					return
+				}
+				// a nil channel is never ready, so this output is skipped until the next value
+				cases[chosen].Chan = reflect.Value{}
+			}
+		}
+	}()
+	return outs
+}
+
+// Dedup returns a channel that delivers only the first occurrence of each value from `in`. It remembers
+// every distinct value it has seen, so its memory use grows with the number of distinct values.
+func Dedup[T comparable](ctx context.Context, in <-chan T) <-chan T {
+	return DedupBy(ctx, in, func(value T) T { return value })
+}
+
+// DedupBy is like `Dedup`, but compares the result of passing each value through `iteratee`.
+func DedupBy[T any, U comparable](ctx context.Context, in <-chan T, iteratee func(T) U) <-chan T {
+	seen := make(map[U]struct{})
+	return Filter(ctx, in, func(value T, _ int) bool {
+		key := iteratee(value)
+		if _, found := seen[key]; found {
+			return false
+		}
+		seen[key] = struct{}{}
+		return true
+	})
+}
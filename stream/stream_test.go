@@ -0,0 +1,163 @@
+package stream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func ExampleFromSlice() {
+	ctx := context.Background()
+	fmt.Println(ToSlice(ctx, FromSlice(ctx, []int{1, 2, 3})))
+	// Output:
+	// [1 2 3] <nil>
+}
+
+func ExampleMap() {
+	ctx := context.Background()
+	fmt.Println(ToSlice(ctx, Map(ctx, FromSlice(ctx, []string{"a", "b"}), strings.ToUpper)))
+	// Output:
+	// [A B] <nil>
+}
+
+func ExampleFilter() {
+	ctx := context.Background()
+	even := func(value int, _ int) bool { return value%2 == 0 }
+	fmt.Println(ToSlice(ctx, Filter(ctx, FromSlice(ctx, []int{1, 2, 3, 4}), even)))
+	// Output:
+	// [2 4] <nil>
+}
+
+func ExampleBatch() {
+	ctx := context.Background()
+	fmt.Println(ToSlice(ctx, Batch(ctx, FromSlice(ctx, []int{1, 2, 3, 4, 5}), 2, 0)))
+	// Output:
+	// [[1 2] [3 4] [5]] <nil>
+}
+
+func ExampleMerge() {
+	ctx := context.Background()
+	merged, _ := ToSlice(ctx, Merge(ctx, FromSlice(ctx, []int{1, 2}), FromSlice(ctx, []int{3, 4})))
+	sort.Ints(merged)
+	fmt.Println(merged)
+	// Output:
+	// [1 2 3 4]
+}
+
+func ExampleTee() {
+	ctx := context.Background()
+	outs := Tee(ctx, FromSlice(ctx, []int{1, 2, 3}), 2)
+	var wg sync.WaitGroup
+	results := make([][]int, len(outs))
+	for i, out := range outs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i], _ = ToSlice(ctx, out)
+		}()
+	}
+	wg.Wait()
+	fmt.Println(results)
+	// Output:
+	// [[1 2 3] [1 2 3]]
+}
+
+func ExampleDedup() {
+	ctx := context.Background()
+	fmt.Println(ToSlice(ctx, Dedup(ctx, FromSlice(ctx, []int{1, 2, 1, 3, 2}))))
+	// Output:
+	// [1 2 3] <nil>
+}
+
+func ExampleDedupBy() {
+	ctx := context.Background()
+	fmt.Println(ToSlice(ctx, DedupBy(ctx, FromSlice(ctx, []string{"a", "B", "A", "b"}), strings.ToLower)))
+	// Output:
+	// [a B] <nil>
+}
+
+func TestFanOut(t *testing.T) {
+	ctx := context.Background()
+	in := make([]int, 100)
+	for i := range in {
+		in[i] = i
+	}
+	outs := FanOut(ctx, FromSlice(ctx, in), 4)
+	if len(outs) != 4 {
+		t.Fatal(4, len(outs))
+	}
+	got, err := ToSlice(ctx, Merge(ctx, outs...))
+	if err != nil {
+		t.Fatal(err)
+	}
+	sort.Ints(got)
+	if !reflect.DeepEqual(got, in) {
+		t.Error(in, got)
+	}
+}
+
+func TestBatchInterval(t *testing.T) {
+	ctx := context.Background()
+	in := make(chan int)
+	batches := Batch(ctx, in, 10, 20*time.Millisecond)
+	go func() {
+		defer close(in)
+		in <- 1
+		in <- 2
+		time.Sleep(100 * time.Millisecond)
+		in <- 3
+	}()
+	got, err := ToSlice(ctx, batches)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := [][]int{{1, 2}, {3}}; !reflect.DeepEqual(got, want) {
+		t.Error(want, got)
+	}
+}
+
+// waitForGoroutines fails the test if the number of goroutines does not drop back to `baseline`.
+func waitForGoroutines(t *testing.T, baseline int) {
+	t.Helper()
+	for i := 0; i < 100; i++ {
+		if runtime.NumGoroutine() <= baseline {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Error("goroutines leaked", baseline, runtime.NumGoroutine())
+}
+
+func TestCancelStopsAllStages(t *testing.T) {
+	baseline := runtime.NumGoroutine()
+	ctx, cancel := context.WithCancel(context.Background())
+
+	// an endless source that nobody ever closes
+	source := make(chan int)
+	go func() {
+		defer close(source)
+		for i := 0; send(ctx, source, i); i++ {
+		}
+	}()
+	doubled := Map(ctx, source, func(v int) int { return v * 2 })
+	tees := Tee(ctx, doubled, 2)
+	fans := FanOut(ctx, tees[0], 3)
+	merged := Merge(ctx, fans...)
+	batches := Batch(ctx, Dedup(ctx, merged), 5, 10*time.Millisecond)
+
+	// the unread tee output holds up the stream, so the batch is cut short by its interval
+	<-batches
+	cancel()
+
+	if _, err := ToSlice(ctx, batches); !errors.Is(err, context.Canceled) {
+		t.Error(context.Canceled, err)
+	}
+	waitForGoroutines(t, baseline)
+}
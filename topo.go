@@ -0,0 +1,186 @@
+package slicy
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/exp/constraints"
+	"golang.org/x/exp/slices"
+)
+
+// CycleError is returned by the topological sorting functions when the dependencies of some elements
+// form a cycle. `Cycle` lists the IDs in the cycle, each depending on the next, and the last depending
+// on the first.
+type CycleError[K comparable] struct {
+	Cycle []K
+}
+
+func (e *CycleError[K]) Error() string {
+	ids := Map(e.Cycle, func(id K) string { return fmt.Sprint(id) })
+	if len(ids) > 0 {
+		ids = append(ids, ids[0])
+	}
+	return "slicy: dependency cycle: " + strings.Join(ids, " -> ")
+}
+
+// ErrUnknownDependency is returned by the topological sorting functions when an element depends on an
+// ID that no element in the slice has.
+var ErrUnknownDependency = errors.New("slicy: unknown dependency")
+
+// TopoSort orders `slice` so that every element comes after the elements it depends on. Each element has
+// an ID given by `idFn`, and `depsFn` returns the IDs of the elements it depends on. The sort is stable:
+// whenever more than one element could come next, the one that is earliest in `slice` is picked. Returns
+// a `*CycleError` if the dependencies form a cycle, `ErrUnknownDependency` if an element depends on an ID
+// that is not in `slice`, and `ErrDuplicateID` if two elements have the same ID.
+func TopoSort[S ~[]T, T any, K comparable](slice S, idFn func(T) K, depsFn func(T) []K) (S, error) {
+	return topoSort(slice, idFn, depsFn, func(i, j int) bool { return i < j })
+}
+
+// TopoSortBy is like `TopoSort`, but whenever more than one element could come next, it picks the one
+// with the smallest result from `iteratee`, falling back to the order of `slice` for equal results.
+// Passing the ID as `iteratee` gives a lexicographic order that does not depend on the order of `slice`.
+func TopoSortBy[S ~[]T, T any, K comparable, U constraints.Ordered](slice S, idFn func(T) K, depsFn func(T) []K, iteratee func(T) U) (S, error) {
+	keys := Map(slice, iteratee)
+	return topoSort(slice, idFn, depsFn, func(i, j int) bool {
+		return keys[i] < keys[j] || (keys[i] == keys[j] && i < j)
+	})
+}
+
+// TopoLevels groups `slice` into waves, so that the elements in each wave depend only on elements in
+// earlier waves. The first wave has the elements without dependencies, and each element is in the
+// earliest wave it can be, so all elements of a wave can be processed in parallel once the waves before
+// it are done. Elements in a wave keep the order of `slice`. Returns the same errors as `TopoSort`.
+func TopoLevels[S ~[]T, T any, K comparable](slice S, idFn func(T) K, depsFn func(T) []K) ([]S, error) {
+	return topoLevels(slice, idFn, depsFn, func(i, j int) bool { return i < j })
+}
+
+// TopoLevelsBy is like `TopoLevels`, but orders the elements in each wave by the result of `iteratee`,
+// falling back to the order of `slice` for equal results.
+func TopoLevelsBy[S ~[]T, T any, K comparable, U constraints.Ordered](slice S, idFn func(T) K, depsFn func(T) []K, iteratee func(T) U) ([]S, error) {
+	keys := Map(slice, iteratee)
+	return topoLevels(slice, idFn, depsFn, func(i, j int) bool {
+		return keys[i] < keys[j] || (keys[i] == keys[j] && i < j)
+	})
+}
+
+// dependencyGraph holds the dependencies of the elements of a slice by index.
+type dependencyGraph[K comparable] struct {
+	ids        []K
+	deps       [][]int
+	dependents [][]int
+	// pending counts the dependencies of each element that have not been placed yet
+	pending []int
+}
+
+func newDependencyGraph[S ~[]T, T any, K comparable](slice S, idFn func(T) K, depsFn func(T) []K) (*dependencyGraph[K], error) {
+	g := &dependencyGraph[K]{
+		ids:        Map(slice, idFn),
+		deps:       make([][]int, len(slice)),
+		dependents: make([][]int, len(slice)),
+		pending:    make([]int, len(slice)),
+	}
+	indexes := make(map[K]int, len(slice))
+	for i, id := range g.ids {
+		if _, found := indexes[id]; found {
+			return nil, fmt.Errorf("%w: %v", ErrDuplicateID, id)
+		}
+		indexes[id] = i
+	}
+	for i, item := range slice {
+		for _, dep := range depsFn(item) {
+			j, found := indexes[dep]
+			if !found {
+				return nil, fmt.Errorf("%w: %v depends on %v", ErrUnknownDependency, g.ids[i], dep)
+			}
+			g.deps[i] = append(g.deps[i], j)
+			g.dependents[j] = append(g.dependents[j], i)
+			g.pending[i]++
+		}
+	}
+	return g, nil
+}
+
+// place marks element `i` as placed, and returns the dependents that have no pending dependencies left.
+func (g *dependencyGraph[K]) place(i int) []int {
+	ready := make([]int, 0)
+	for _, j := range g.dependents[i] {
+		g.pending[j]--
+		if g.pending[j] == 0 {
+			ready = append(ready, j)
+		}
+	}
+	return ready
+}
+
+// cycle finds a cycle among the elements that could not be placed. Each of them has a pending
+// dependency, so following those from any of them must eventually come back round.
+func (g *dependencyGraph[K]) cycle() *CycleError[K] {
+	start := FindIndex(g.pending, func(n int) bool { return n > 0 })
+	seen := make(map[int]int)
+	path := make([]int, 0)
+	for i := start; ; {
+		if p, found := seen[i]; found {
+			return &CycleError[K]{Map(path[p:], func(j int) K { return g.ids[j] })}
+		}
+		seen[i] = len(path)
+		path = append(path, i)
+		i = g.deps[i][FindIndex(g.deps[i], func(j int) bool { return g.pending[j] > 0 })]
+	}
+}
+
+func topoSort[S ~[]T, T any, K comparable](slice S, idFn func(T) K, depsFn func(T) []K, before func(i, j int) bool) (S, error) {
+	g, err := newDependencyGraph(slice, idFn, depsFn)
+	if err != nil {
+		return nil, err
+	}
+	ready := NewHeap(before)
+	for i, n := range g.pending {
+		if n == 0 {
+			ready.Push(i)
+		}
+	}
+	output := make(S, 0, len(slice))
+	for ready.Len() > 0 {
+		i, _ := ready.Pop()
+		output = append(output, slice[i])
+		for _, j := range g.place(i) {
+			ready.Push(j)
+		}
+	}
+	if len(output) < len(slice) {
+		return nil, g.cycle()
+	}
+	return output, nil
+}
+
+func topoLevels[S ~[]T, T any, K comparable](slice S, idFn func(T) K, depsFn func(T) []K, before func(i, j int) bool) ([]S, error) {
+	g, err := newDependencyGraph(slice, idFn, depsFn)
+	if err != nil {
+		return nil, err
+	}
+	level := make([]int, 0)
+	for i, n := range g.pending {
+		if n == 0 {
+			level = append(level, i)
+		}
+	}
+	output := make([]S, 0)
+	placed := 0
+	for len(level) > 0 {
+		slices.SortFunc(level, before)
+		next := make([]int, 0)
+		wave := make(S, 0, len(level))
+		for _, i := range level {
+			wave = append(wave, slice[i])
+			next = append(next, g.place(i)...)
+		}
+		output = append(output, wave)
+		placed += len(level)
+		level = next
+	}
+	if placed < len(slice) {
+		return nil, g.cycle()
+	}
+	return output, nil
+}
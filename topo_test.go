@@ -0,0 +1,91 @@
+package slicy
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+type step struct {
+	name string
+	deps []string
+}
+
+func stepName(s step) string { return s.name }
+
+func stepDeps(s step) []string { return s.deps }
+
+var steps = []step{
+	{"test", []string{"build"}},
+	{"deploy", []string{"test", "migrate"}},
+	{"migrate", nil},
+	{"build", []string{"fetch"}},
+	{"fetch", nil},
+}
+
+func ExampleTopoSort() {
+	sorted, err := TopoSort(steps, stepName, stepDeps)
+	fmt.Println(Map(sorted, stepName), err)
+
+	_, err = TopoSort([]step{{"a", []string{"b"}}, {"b", []string{"c"}}, {"c", []string{"a"}}, {"d", nil}}, stepName, stepDeps)
+	var cycle *CycleError[string]
+	fmt.Println(errors.As(err, &cycle), cycle.Cycle)
+	fmt.Println(err)
+	// Output:
+	// [migrate fetch build test deploy] <nil>
+	// true [a b c]
+	// slicy: dependency cycle: a -> b -> c -> a
+}
+
+func ExampleTopoSortBy() {
+	sorted, _ := TopoSortBy(steps, stepName, stepDeps, stepName)
+	fmt.Println(Map(sorted, stepName))
+	// Output:
+	// [fetch build migrate test deploy]
+}
+
+func ExampleTopoLevels() {
+	levels, _ := TopoLevels(steps, stepName, stepDeps)
+	fmt.Println(Map(levels, func(level []step) []string { return Map(level, stepName) }))
+	// Output:
+	// [[migrate fetch] [build] [test] [deploy]]
+}
+
+func ExampleTopoLevelsBy() {
+	levels, _ := TopoLevelsBy(steps, stepName, stepDeps, stepName)
+	fmt.Println(Map(levels, func(level []step) []string { return Map(level, stepName) }))
+	// Output:
+	// [[fetch migrate] [build] [test] [deploy]]
+}
+
+func TestTopoErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		steps []step
+		o     error
+		cycle []string
+	}{
+		{"empty", []step{}, nil, nil},
+		{"unknown", []step{{"a", []string{"z"}}}, ErrUnknownDependency, nil},
+		{"duplicate", []step{{"a", nil}, {"a", nil}}, ErrDuplicateID, nil},
+		{"self", []step{{"a", []string{"a"}}}, nil, []string{"a"}},
+		{"behind cycle", []step{{"x", []string{"b"}}, {"a", []string{"b"}}, {"b", []string{"a"}}}, nil, []string{"b", "a"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, sortErr := TopoSort(test.steps, stepName, stepDeps)
+			_, levelsErr := TopoLevels(test.steps, stepName, stepDeps)
+			for _, op := range []error{sortErr, levelsErr} {
+				var cycle *CycleError[string]
+				if test.cycle != nil {
+					if !errors.As(op, &cycle) || !reflect.DeepEqual(cycle.Cycle, test.cycle) {
+						t.Error(test.cycle, op)
+					}
+				} else if !errors.Is(op, test.o) || (test.o == nil && op != nil) {
+					t.Error(test.o, op)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,173 @@
+package slicy
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Node is an element of a tree built by `BuildTree`, along with the nodes whose parent it is.
+type Node[T any] struct {
+	Value    T
+	Children []*Node[T]
+}
+
+// OrphanMode controls what `BuildTree` does with orphans: elements whose parent ID is not the ID of
+// any element in the slice.
+type OrphanMode int
+
+const (
+	// OrphansAsRoots makes each orphan the root of its own tree.
+	OrphansAsRoots OrphanMode = iota
+	// OrphansDrop leaves orphans and their descendants out of the forest.
+	OrphansDrop
+	// OrphansError makes `BuildTree` return `ErrOrphan`.
+	OrphansError
+)
+
+// WalkOrder is the order in which `Walk` visits the nodes of a forest.
+type WalkOrder int
+
+const (
+	// PreOrder visits each node before its children, depth first.
+	PreOrder WalkOrder = iota
+	// PostOrder visits each node after its children, depth first.
+	PostOrder
+	// BreadthFirst visits all nodes at one depth before any at the next.
+	BreadthFirst
+)
+
+var (
+	// ErrDuplicateID is returned by `BuildTree` and the topological sorting functions when two elements
+	// have the same ID.
+	ErrDuplicateID = errors.New("slicy: duplicate id")
+	// ErrOrphan is returned by `BuildTree` with `OrphansError` when an element's parent is not in the slice.
+	ErrOrphan = errors.New("slicy: parent not found")
+	// ErrTreeCycle is returned by `BuildTree` when following parents from an element leads back to it.
+	ErrTreeCycle = errors.New("slicy: parent cycle")
+)
+
+// BuildTree builds a forest from the flat `slice`, in which each element has an ID given by `idFn`
+// and refers to its parent by ID through `parentFn`. Elements for which `parentFn` returns false are
+// roots. Roots and the children of each node are in the order they occur in `slice`. Elements whose
+// parent is missing from `slice` are handled according to `orphans`. Returns `ErrDuplicateID` if two
+// elements have the same ID and `ErrTreeCycle` if any elements are their own ancestors.
+func BuildTree[S ~[]T, T any, K comparable](slice S, idFn func(T) K, parentFn func(T) (K, bool), orphans OrphanMode) ([]*Node[T], error) {
+	nodes := make([]*Node[T], len(slice))
+	byID := make(map[K]*Node[T], len(slice))
+	for i, item := range slice {
+		id := idFn(item)
+		if _, found := byID[id]; found {
+			return nil, fmt.Errorf("%w: %v", ErrDuplicateID, id)
+		}
+		nodes[i] = &Node[T]{Value: item}
+		byID[id] = nodes[i]
+	}
+
+	roots := make([]*Node[T], 0)
+	dropped := make([]*Node[T], 0)
+	for i, item := range slice {
+		parentID, hasParent := parentFn(item)
+		parent, found := byID[parentID]
+		switch {
+		case !hasParent:
+			roots = append(roots, nodes[i])
+		case found:
+			parent.Children = append(parent.Children, nodes[i])
+		case orphans == OrphansAsRoots:
+			roots = append(roots, nodes[i])
+		case orphans == OrphansDrop:
+			dropped = append(dropped, nodes[i])
+		default:
+			return nil, fmt.Errorf("%w: %v has parent %v", ErrOrphan, idFn(item), parentID)
+		}
+	}
+
+	// every node reachable from a root or a dropped orphan has a proper chain of parents, so any
+	// other node must be part of, or hang off, a cycle
+	reached := make(map[*Node[T]]bool, len(nodes))
+	Walk(append(append([]*Node[T]{}, roots...), dropped...), PreOrder, func(node *Node[T], _ int) bool {
+		reached[node] = true
+		return true
+	})
+	for i, node := range nodes {
+		if !reached[node] {
+			return nil, fmt.Errorf("%w: involving %v", ErrTreeCycle, idFn(slice[i]))
+		}
+	}
+	return roots, nil
+}
+
+// Walk calls `visit` for each node of the forest under `roots` in the given `order`, along with its
+// depth, which is 0 for the roots. It stops as soon as `visit` returns false.
+func Walk[T any](roots []*Node[T], order WalkOrder, visit func(node *Node[T], depth int) bool) {
+	if order == BreadthFirst {
+		walkBreadthFirst(roots, visit)
+		return
+	}
+	walkDepthFirst(roots, 0, order == PostOrder, visit)
+}
+
+// walkDepthFirst visits `nodes` and their descendants, returning false if `visit` asked to stop.
+func walkDepthFirst[T any](nodes []*Node[T], depth int, post bool, visit func(node *Node[T], depth int) bool) bool {
+	for _, node := range nodes {
+		if !post && !visit(node, depth) {
+			return false
+		}
+		if !walkDepthFirst(node.Children, depth+1, post, visit) {
+			return false
+		}
+		if post && !visit(node, depth) {
+			return false
+		}
+	}
+	return true
+}
+
+func walkBreadthFirst[T any](roots []*Node[T], visit func(node *Node[T], depth int) bool) {
+	level := roots
+	for depth := 0; len(level) > 0; depth++ {
+		next := make([]*Node[T], 0)
+		for _, node := range level {
+			if !visit(node, depth) {
+				return
+			}
+			next = append(next, node.Children...)
+		}
+		level = next
+	}
+}
+
+// FlattenTree returns the values of all the nodes of the forest under `roots`, in the given `order`.
+func FlattenTree[T any](roots []*Node[T], order WalkOrder) []T {
+	output := make([]T, 0)
+	Walk(roots, order, func(node *Node[T], _ int) bool {
+		output = append(output, node.Value)
+		return true
+	})
+	return output
+}
+
+// FindPath searches the forest under `roots` depth first for a node whose value `predicate` returns true
+// for, and returns the values on the path from its root down to it. Returns false if no node matches.
+func FindPath[T any](roots []*Node[T], predicate func(T) bool) ([]T, bool) {
+	for _, root := range roots {
+		if predicate(root.Value) {
+			return []T{root.Value}, true
+		}
+		if path, found := FindPath(root.Children, predicate); found {
+			return append([]T{root.Value}, path...), true
+		}
+	}
+	return nil, false
+}
+
+// Depth returns the number of levels in the forest under `roots`: 0 if there are no roots, 1 if none
+// of them have children, and so on.
+func Depth[T any](roots []*Node[T]) int {
+	depth := 0
+	Walk(roots, PreOrder, func(_ *Node[T], d int) bool {
+		depth = max(depth, d+1)
+		return true
+	})
+	return depth
+}
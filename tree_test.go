@@ -0,0 +1,102 @@
+package slicy
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+type record struct {
+	ID       int
+	ParentID int
+}
+
+func recordID(r record) int { return r.ID }
+
+func recordParent(r record) (int, bool) { return r.ParentID, r.ParentID != 0 }
+
+// records form the forest 1 -> (2 -> 4, 3), 5 -> 6, with 7 an orphan whose parent 9 is missing.
+var records = []record{{1, 0}, {2, 1}, {3, 1}, {4, 2}, {5, 0}, {6, 5}, {7, 9}}
+
+func ExampleBuildTree() {
+	roots, err := BuildTree(records, recordID, recordParent, OrphansAsRoots)
+	fmt.Println(len(roots), err)
+	fmt.Println(roots[0].Value, roots[0].Children[0].Children[0].Value)
+
+	roots, err = BuildTree(records, recordID, recordParent, OrphansDrop)
+	fmt.Println(len(roots), err)
+
+	_, err = BuildTree(records, recordID, recordParent, OrphansError)
+	fmt.Println(err)
+	// Output:
+	// 3 <nil>
+	// {1 0} {4 2}
+	// 2 <nil>
+	// slicy: parent not found: 7 has parent 9
+}
+
+func ExampleWalk() {
+	roots, _ := BuildTree(records, recordID, recordParent, OrphansDrop)
+	Walk(roots, PreOrder, func(node *Node[record], depth int) bool {
+		fmt.Println(depth, node.Value.ID)
+		return node.Value.ID != 3
+	})
+	// Output:
+	// 0 1
+	// 1 2
+	// 2 4
+	// 1 3
+}
+
+func ExampleFlattenTree() {
+	roots, _ := BuildTree(records, recordID, recordParent, OrphansDrop)
+	ids := func(rs []record) []int { return Map(rs, recordID) }
+	fmt.Println(ids(FlattenTree(roots, PreOrder)))
+	fmt.Println(ids(FlattenTree(roots, PostOrder)))
+	fmt.Println(ids(FlattenTree(roots, BreadthFirst)))
+	// Output:
+	// [1 2 4 3 5 6]
+	// [4 2 3 1 6 5]
+	// [1 5 2 3 6 4]
+}
+
+func ExampleFindPath() {
+	roots, _ := BuildTree(records, recordID, recordParent, OrphansDrop)
+	fmt.Println(FindPath(roots, func(r record) bool { return r.ID == 4 }))
+	fmt.Println(FindPath(roots, func(r record) bool { return r.ID == 7 }))
+	// Output:
+	// [{1 0} {2 1} {4 2}] true
+	// [] false
+}
+
+func ExampleDepth() {
+	roots, _ := BuildTree(records, recordID, recordParent, OrphansDrop)
+	fmt.Println(Depth(roots), Depth(roots[1:]), Depth[record](nil))
+	// Output:
+	// 3 2 0
+}
+
+func TestBuildTreeErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		records []record
+		orphans OrphanMode
+		o       error
+	}{
+		{"valid", []record{{1, 0}, {2, 1}}, OrphansError, nil},
+		{"duplicate", []record{{1, 0}, {1, 0}}, OrphansAsRoots, ErrDuplicateID},
+		{"self parent", []record{{1, 0}, {2, 2}}, OrphansAsRoots, ErrTreeCycle},
+		{"cycle", []record{{1, 0}, {2, 3}, {3, 4}, {4, 2}}, OrphansAsRoots, ErrTreeCycle},
+		{"hanging off cycle", []record{{2, 3}, {3, 2}, {5, 3}}, OrphansDrop, ErrTreeCycle},
+		{"descendant of dropped orphan", []record{{2, 9}, {3, 2}}, OrphansDrop, nil},
+		{"orphan", []record{{2, 9}, {3, 2}}, OrphansError, ErrOrphan},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, op := BuildTree(test.records, recordID, recordParent, test.orphans)
+			if !errors.Is(op, test.o) || (test.o == nil && op != nil) {
+				t.Error(test.o, op)
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package slicy
+
+import (
+	"github.com/sudhirj/slicy/seq"
+)
+
+// ErrInvalidSize is returned (or, for `Chunk` and `seq.Chunk`, raised as a panic) when a chunk or window
+// size, or a step between windows, is less than 1. It is the same value as `seq.ErrInvalidSize`.
+var ErrInvalidSize = seq.ErrInvalidSize
+
+// Windows returns the slices of `size` consecutive elements starting at every `step` elements of
+// `slice`. A `step` smaller than `size` gives overlapping windows, and a larger one skips elements.
+// Only full windows are returned, so a slice shorter than `size` gives no windows. Each window shares
+// memory with `slice`, with its capacity limited so that appending to it cannot overwrite `slice`.
+// Returns `ErrInvalidSize` if `size` or `step` is less than 1.
+func Windows[S ~[]T, T any](slice S, size int, step int) ([]S, error) {
+	if size < 1 || step < 1 {
+		return nil, ErrInvalidSize
+	}
+	output := make([]S, 0)
+	// written as remaining lengths, so that a huge `size` or `step` cannot overflow
+	for start := 0; len(slice)-start >= size; start += step {
+		output = append(output, slice[start:start+size:start+size])
+		if step > len(slice)-start {
+			break
+		}
+	}
+	return output, nil
+}
+
+// Pairwise returns every pair of adjacent elements in `slice`. A slice with fewer than two elements gives
+// no pairs.
+func Pairwise[S ~[]T, T any](slice S) []Pair[T, T] {
+	if len(slice) < 2 {
+		return make([]Pair[T, T], 0)
+	}
+	output := make([]Pair[T, T], len(slice)-1)
+	for i := range output {
+		output[i] = Pair[T, T]{slice[i], slice[i+1]}
+	}
+	return output
+}
+
+// ChunkBy splits `slice` into runs of consecutive elements for which `iteratee` returns the same key,
+// starting a new chunk whenever the key changes between neighbors. `iteratee` is called once per element.
+// Each chunk shares memory with `slice`, with its capacity limited so that appending to it cannot
+// overwrite `slice`.
+func ChunkBy[S ~[]T, T any, U comparable](slice S, iteratee func(T) U) []S {
+	keys := Map(slice, iteratee)
+	return chunkAt(slice, func(i int) bool { return keys[i-1] != keys[i] })
+}
+
+// ChunkWith splits `slice` into runs of consecutive elements, starting a new chunk whenever `comparator`
+// returns false for two neighboring elements. Each chunk shares memory with `slice`, with its capacity
+// limited so that appending to it cannot overwrite `slice`.
+func ChunkWith[S ~[]T, T any](slice S, comparator func(previous, next T) bool) []S {
+	return chunkAt(slice, func(i int) bool { return !comparator(slice[i-1], slice[i]) })
+}
+
+// chunkAt splits `slice` before every index `i` for which `split(i)` returns true.
+func chunkAt[S ~[]T, T any](slice S, split func(i int) bool) []S {
+	output := make([]S, 0)
+	start := 0
+	for i := 1; i <= len(slice); i++ {
+		if i == len(slice) || split(i) {
+			output = append(output, slice[start:i:i])
+			start = i
+		}
+	}
+	return output
+}
+
+// ChunkPadded is like `Chunk`, but if the slice cannot be split evenly the last chunk is filled up to
+// `chunkSize` with `pad`. The last chunk is then newly allocated, while the others share memory with
+// `slice`. Returns `ErrInvalidSize` if `chunkSize` is less than 1.
+func ChunkPadded[S ~[]T, T any](slice S, chunkSize int, pad T) ([]S, error) {
+	if chunkSize < 1 {
+		return nil, ErrInvalidSize
+	}
+	output := Chunk(slice, chunkSize)
+	if len(output) > 0 {
+		last := output[len(output)-1]
+		if missing := chunkSize - len(last); missing > 0 {
+			padded := make(S, chunkSize)
+			copy(padded, last)
+			Fill(padded, pad, len(last), chunkSize)
+			output[len(output)-1] = padded
+		}
+	}
+	return output, nil
+}
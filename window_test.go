@@ -0,0 +1,100 @@
+package slicy
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestWindows(t *testing.T) {
+	tests := []struct {
+		name string
+		i    []int
+		size int
+		step int
+		o    [][]int
+		err  error
+	}{
+		{"empty", []int{}, 2, 1, [][]int{}, nil},
+		{"too short", []int{1}, 2, 1, [][]int{}, nil},
+		{"sliding", []int{1, 2, 3, 4}, 2, 1, [][]int{{1, 2}, {2, 3}, {3, 4}}, nil},
+		{"tumbling", []int{1, 2, 3, 4, 5}, 2, 2, [][]int{{1, 2}, {3, 4}}, nil},
+		{"strided", []int{1, 2, 3, 4, 5, 6, 7}, 2, 3, [][]int{{1, 2}, {4, 5}}, nil},
+		{"huge step", []int{1, 2, 3}, 1, math.MaxInt, [][]int{{1}}, nil},
+		{"huge size", []int{1, 2, 3}, math.MaxInt, 1, [][]int{}, nil},
+		{"zero size", []int{1, 2}, 0, 1, nil, ErrInvalidSize},
+		{"zero step", []int{1, 2}, 1, 0, nil, ErrInvalidSize},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			op, err := Windows(test.i, test.size, test.step)
+			if !reflect.DeepEqual(op, test.o) || !errors.Is(err, test.err) {
+				t.Error(test.o, test.err, op, err)
+			}
+		})
+	}
+}
+
+func TestWindowsShareMemory(t *testing.T) {
+	input := []int{1, 2, 3, 4}
+	windows, _ := Windows(input, 2, 1)
+	windows[1][0] = 42
+	if input[1] != 42 {
+		t.Error("expected windows to share memory with the input")
+	}
+	_ = append(windows[0], 99)
+	if input[2] != 3 {
+		t.Error("expected appending to a window to leave the input alone")
+	}
+}
+
+func TestChunkInvalidSize(t *testing.T) {
+	defer func() {
+		if r := recover(); r != ErrInvalidSize {
+			t.Error("expected a panic with ErrInvalidSize, got", r)
+		}
+	}()
+	Chunk([]int{1, 2}, 0)
+}
+
+func ExampleWindows() {
+	fmt.Println(Windows([]int{1, 2, 3, 4, 5}, 3, 1))
+	fmt.Println(Windows([]int{1, 2, 3, 4, 5}, 2, 2))
+	fmt.Println(Windows([]int{1, 2, 3}, 0, 1))
+	// Output:
+	// [[1 2 3] [2 3 4] [3 4 5]] <nil>
+	// [[1 2] [3 4]] <nil>
+	// [] slicy: size and step must be at least 1
+}
+
+func ExamplePairwise() {
+	fmt.Println(Pairwise([]int{1, 2, 3}))
+	fmt.Println(Pairwise([]int{1}))
+	// Output:
+	// [{1 2} {2 3}]
+	// []
+}
+
+func ExampleChunkBy() {
+	fmt.Println(ChunkBy([]int{1, 3, 2, 4, 5, 6}, func(n int) bool { return n%2 == 0 }))
+	fmt.Println(ChunkBy([]string{}, func(s string) int { return len(s) }))
+	// Output:
+	// [[1 3] [2 4] [5] [6]]
+	// []
+}
+
+func ExampleChunkWith() {
+	fmt.Println(ChunkWith([]int{1, 2, 3, 7, 8, 10}, func(previous, next int) bool { return next == previous+1 }))
+	// Output:
+	// [[1 2 3] [7 8] [10]]
+}
+
+func ExampleChunkPadded() {
+	fmt.Println(ChunkPadded([]int{1, 2, 3, 4, 5}, 2, 0))
+	fmt.Println(ChunkPadded([]int{1, 2, 3, 4}, 2, 0))
+	// Output:
+	// [[1 2] [3 4] [5 0]] <nil>
+	// [[1 2] [3 4]] <nil>
+}
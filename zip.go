@@ -0,0 +1,101 @@
+package slicy
+
+// Pair holds two values of possibly different types, like one row of two zipped slices.
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// Triple holds three values of possibly different types, like one row of three zipped slices.
+type Triple[A, B, C any] struct {
+	First  A
+	Second B
+	Third  C
+}
+
+// ZipLength decides what the zipping functions do when given slices of unequal length.
+type ZipLength int
+
+const (
+	// ZipShortest truncates the output to the length of the shortest slice.
+	ZipShortest ZipLength = iota
+	// ZipLongest extends the output to the length of the longest slice, padding the shorter ones with zero values.
+	ZipLongest
+)
+
+func zipLength(length ZipLength, lengths ...int) int {
+	n := lengths[0]
+	for _, l := range lengths[1:] {
+		if length == ZipLongest && l > n || length == ZipShortest && l < n {
+			n = l
+		}
+	}
+	return n
+}
+
+// at returns the element at index `i` of `slice`, or the zero value if `i` is out of range.
+func at[T any](slice []T, i int) (result T) {
+	if i < len(slice) {
+		result = slice[i]
+	}
+	return
+}
+
+// Zip2 groups the elements of `as` and `bs` by index into pairs, with `length` deciding how
+// slices of unequal length are handled.
+func Zip2[A, B any](as []A, bs []B, length ZipLength) []Pair[A, B] {
+	return ZipWith(as, bs, length, func(a A, b B) Pair[A, B] { return Pair[A, B]{a, b} })
+}
+
+// Zip3 groups the elements of `as`, `bs` and `cs` by index into triples, with `length` deciding how
+// slices of unequal length are handled.
+func Zip3[A, B, C any](as []A, bs []B, cs []C, length ZipLength) []Triple[A, B, C] {
+	output := make([]Triple[A, B, C], zipLength(length, len(as), len(bs), len(cs)))
+	for i := range output {
+		output[i] = Triple[A, B, C]{at(as, i), at(bs, i), at(cs, i)}
+	}
+	return output
+}
+
+// ZipWith creates a slice of values by passing the elements of `as` and `bs` at each index to `combine`,
+// with `length` deciding how slices of unequal length are handled.
+func ZipWith[A, B, U any](as []A, bs []B, length ZipLength, combine func(A, B) U) []U {
+	output := make([]U, zipLength(length, len(as), len(bs)))
+	for i := range output {
+		output[i] = combine(at(as, i), at(bs, i))
+	}
+	return output
+}
+
+// ZipToMap creates a map from each element of `keys` to the element of `values` at the same index, with
+// `length` deciding how slices of unequal length are handled. Values left over without a key are always
+// dropped. If a key is repeated, the last value for it is kept.
+func ZipToMap[K comparable, V any](keys []K, values []V, length ZipLength) map[K]V {
+	n := len(keys)
+	if length == ZipShortest && len(values) < n {
+		n = len(values)
+	}
+	output := make(map[K]V, n)
+	for i := 0; i < n; i++ {
+		output[keys[i]] = at(values, i)
+	}
+	return output
+}
+
+// Unzip2 splits a slice of pairs into a slice of the first values and a slice of the second values.
+func Unzip2[A, B any](pairs []Pair[A, B]) ([]A, []B) {
+	as, bs := make([]A, len(pairs)), make([]B, len(pairs))
+	for i, p := range pairs {
+		as[i], bs[i] = p.First, p.Second
+	}
+	return as, bs
+}
+
+// Unzip3 splits a slice of triples into slices of the first, second and third values.
+func Unzip3[A, B, C any](triples []Triple[A, B, C]) ([]A, []B, []C) {
+	as, bs, cs := make([]A, len(triples)), make([]B, len(triples)), make([]C, len(triples))
+	for i, t := range triples {
+		as[i], bs[i], cs[i] = t.First, t.Second, t.Third
+	}
+	return as, bs, cs
+}
@@ -0,0 +1,54 @@
+package slicy
+
+import (
+	"fmt"
+)
+
+func ExampleZip2() {
+	ids, names := []int{1, 2, 3}, []string{"a", "b"}
+	fmt.Println(Zip2(ids, names, ZipShortest))
+	fmt.Printf("%+v\n", Zip2(ids, names, ZipLongest))
+	// Output:
+	// [{1 a} {2 b}]
+	// [{First:1 Second:a} {First:2 Second:b} {First:3 Second:}]
+}
+
+func ExampleZip3() {
+	fmt.Println(Zip3([]int{1, 2}, []string{"a", "b"}, []bool{true, false}, ZipShortest))
+	fmt.Println(Zip3([]int{1, 2}, []string{"a"}, []bool{}, ZipShortest))
+	fmt.Println(Zip3([]int{1, 2}, []string{"a"}, []bool{}, ZipLongest))
+	// Output:
+	// [{1 a true} {2 b false}]
+	// []
+	// [{1 a false} {2  false}]
+}
+
+func ExampleZipWith() {
+	fmt.Println(ZipWith([]int{1, 2, 3}, []int{10, 20}, ZipShortest, func(a, b int) int { return a + b }))
+	fmt.Println(ZipWith([]int{1, 2, 3}, []int{10, 20}, ZipLongest, func(a, b int) int { return a + b }))
+	// Output:
+	// [11 22]
+	// [11 22 3]
+}
+
+func ExampleZipToMap() {
+	fmt.Println(ZipToMap([]string{"a", "b", "c"}, []int{1, 2}, ZipShortest))
+	fmt.Println(ZipToMap([]string{"a", "b", "c"}, []int{1, 2}, ZipLongest))
+	fmt.Println(ZipToMap([]string{"a"}, []int{1, 2}, ZipLongest))
+	// Output:
+	// map[a:1 b:2]
+	// map[a:1 b:2 c:0]
+	// map[a:1]
+}
+
+func ExampleUnzip2() {
+	fmt.Println(Unzip2(Zip2([]int{1, 2}, []string{"a", "b"}, ZipShortest)))
+	// Output:
+	// [1 2] [a b]
+}
+
+func ExampleUnzip3() {
+	fmt.Println(Unzip3([]Triple[int, string, bool]{{1, "a", true}, {2, "b", false}}))
+	// Output:
+	// [1 2] [a b] [true false]
+}